@@ -0,0 +1,68 @@
+// Package ashtech parses and encodes Ashtech (now Magellan and Spectra) PASHR
+// and PASHS NMEA sentences.
+//
+// PASHR attitude sentences are also output by many inertial measurement
+// units.
+package ashtech
+
+import (
+	"github.com/twpayne/go-nmea"
+)
+
+var pashrParserMap = map[string]nmea.SentenceParser{
+	"POS": nmea.MakeSentenceParser(ParsePASHRPOS),
+	"RID": nmea.MakeSentenceParser(ParsePASHRRID),
+	"SAT": nmea.MakeSentenceParser(ParsePASHRSAT),
+}
+
+var pashsParserMap = map[string]nmea.SentenceParser{
+	"ELM": nmea.MakeSentenceParser(ParsePASHSELM),
+	"NME": nmea.MakeSentenceParser(ParsePASHSNME),
+	"RST": nmea.MakeSentenceParser(ParsePASHSRST),
+	"SPD": nmea.MakeSentenceParser(ParsePASHSSPD),
+}
+
+type UnknownMessageTypeError struct {
+	MessageType string
+}
+
+func (e *UnknownMessageTypeError) Error() string {
+	return e.MessageType + ": unknown message type"
+}
+
+// ParseSentence parses a PASHR or PASHS sentence. PASHR sentences whose first
+// field is not a known message type are parsed as attitude sentences.
+func ParseSentence(addr string, tok *nmea.Tokenizer) (nmea.Sentence, error) {
+	tokFork := tok.Fork()
+	messageType := tokFork.CommaString()
+	switch addr {
+	case "PASHR":
+		if sentenceParser := pashrParserMap[messageType]; sentenceParser != nil {
+			return sentenceParser(addr, tokFork)
+		}
+		return ParsePASHR(addr, tok)
+	case "PASHS":
+		if err := tokFork.Err(); err != nil {
+			return nil, err
+		}
+		if sentenceParser := pashsParserMap[messageType]; sentenceParser != nil {
+			return sentenceParser(addr, tokFork)
+		}
+		return nil, &UnknownMessageTypeError{
+			MessageType: messageType,
+		}
+	default:
+		return nil, &nmea.UnexpectedAddressError{
+			Address: addr,
+		}
+	}
+}
+
+func SentenceParserFunc(addr string) nmea.SentenceParser {
+	switch addr {
+	case "PASHR", "PASHS":
+		return ParseSentence
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,191 @@
+package ashtech_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/ashtech"
+	"github.com/twpayne/go-nmea/nmeatest"
+)
+
+func TestSentenceParserFunc(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(ashtech.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$PASHR,085335.000,224.19,T,-01.26,+00.83,+00.00,0.101,0.113,0.267,1,0*06",
+				Expected: &ashtech.PASHR{
+					Address: nmea.NewAddress("PASHR"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   8,
+						Minute: 53,
+						Second: 35,
+					},
+					Heading:         224.19,
+					Roll:            -1.26,
+					Pitch:           0.83,
+					Heave:           nmea.NewOptional(0.0),
+					RollAccuracy:    nmea.NewOptional(0.101),
+					PitchAccuracy:   nmea.NewOptional(0.113),
+					HeadingAccuracy: nmea.NewOptional(0.267),
+					GNSSQuality:     nmea.NewOptional(ashtech.GNSSQualityNonRTK),
+					INSStatus:       nmea.NewOptional(ashtech.INSStatusPreAlignment),
+				},
+			},
+			{
+				S: "$PASHR,085335.000,224.19,T,-01.26,+00.83,,,,,,*2C",
+				Expected: &ashtech.PASHR{
+					Address: nmea.NewAddress("PASHR"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   8,
+						Minute: 53,
+						Second: 35,
+					},
+					Heading: 224.19,
+					Roll:    -1.26,
+					Pitch:   0.83,
+				},
+			},
+			{
+				S: "$PASHR,085335.000,224.19,T,-01.26,+00.83,*00",
+				Expected: &ashtech.PASHR{
+					Address: nmea.NewAddress("PASHR"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   8,
+						Minute: 53,
+						Second: 35,
+					},
+					Heading: 224.19,
+					Roll:    -1.26,
+					Pitch:   0.83,
+				},
+			},
+			{
+				S: "$PASHR,POS,0,06,174036.00,3722.366710,N,12159.828830,W,+00030.165,,020.3,000.0,+000.0,03.2,02.1,02.4,02.0,GC00*26",
+				Expected: &ashtech.PASHRPOS{
+					Address:            nmea.NewAddress("PASHR"),
+					PositionMode:       ashtech.PositionModeAutonomous,
+					NumberOfSatellites: 6,
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   17,
+						Minute: 40,
+						Second: 36,
+					},
+					Lat:              37.37277850,
+					Lon:              -121.99714716666667,
+					Alt:              30.165,
+					CourseOverGround: 20.3,
+					PDOP:             nmea.NewOptional(3.2),
+					HDOP:             nmea.NewOptional(2.1),
+					VDOP:             nmea.NewOptional(2.4),
+					TDOP:             nmea.NewOptional(2.0),
+					FirmwareVersion:  "GC00",
+				},
+			},
+			{
+				S: "$PASHR,SAT,04,03,103,56,50,U,23,225,61,46,U,16,045,02,40,-,22,291,03,38,U*66",
+				Expected: &ashtech.PASHRSAT{
+					Address: nmea.NewAddress("PASHR"),
+					SatelliteStatuses: []ashtech.SatelliteStatus{
+						{PRN: 3, Azimuth: 103, Elevation: 56, SNR: 50, Used: true},
+						{PRN: 23, Azimuth: 225, Elevation: 61, SNR: 46, Used: true},
+						{PRN: 16, Azimuth: 45, Elevation: 2, SNR: 40},
+						{PRN: 22, Azimuth: 291, Elevation: 3, SNR: 38, Used: true},
+					},
+				},
+			},
+			{
+				S:           "$PASHR,SAT,1000000000000000*1F",
+				ExpectedErr: nmea.ErrUnexpectedEndOfData,
+			},
+			{
+				S: "$PASHR,RID,UZ,31,UC00,-------,0A00*40",
+				Expected: &ashtech.PASHRRID{
+					Address:         nmea.NewAddress("PASHR"),
+					ReceiverType:    "UZ",
+					ChannelVersion:  "31",
+					FirmwareVersion: "UC00",
+					Options:         "-------",
+					BootVersion:     nmea.NewOptional("0A00"),
+				},
+			},
+			{
+				S: "$PASHS,ELM,10*1C",
+				Expected: &ashtech.PASHSELM{
+					Address:       nmea.NewAddress("PASHS"),
+					ElevationMask: 10,
+				},
+			},
+			{
+				S: "$PASHS,NME,GGA,A,ON*1E",
+				Expected: &ashtech.PASHSNME{
+					Address:  nmea.NewAddress("PASHS"),
+					Sentence: "GGA",
+					Port:     'A',
+					On:       true,
+				},
+			},
+			{
+				S: "$PASHS,RST*20",
+				Expected: &ashtech.PASHSRST{
+					Address: nmea.NewAddress("PASHS"),
+				},
+			},
+			{
+				S: "$PASHS,SPD,A,9*4A",
+				Expected: &ashtech.PASHSSPD{
+					Address:      nmea.NewAddress("PASHS"),
+					Port:         'A',
+					BaudRateCode: 9,
+				},
+			},
+		},
+	)
+}
+
+func TestMarshal(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		sentence nmea.SentenceEncoder
+		expected string
+	}{
+		{
+			name: "nme_off",
+			sentence: &ashtech.PASHSNME{
+				Address:  nmea.NewAddress("PASHS"),
+				Sentence: "ALL",
+				Port:     'B',
+			},
+			expected: "$PASHS,NME,ALL,B,OFF*53\r\n",
+		},
+		{
+			name: "spd",
+			sentence: &ashtech.PASHSSPD{
+				Address:      nmea.NewAddress("PASHS"),
+				Port:         'A',
+				BaudRateCode: 9,
+			},
+			expected: "$PASHS,SPD,A,9*4A\r\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := nmea.Marshal(tc.sentence)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func TestParseSentenceUnexpectedAddress(t *testing.T) {
+	sentence, err := ashtech.ParseSentence("PGRMC", nmea.NewTokenizer([]byte(",A")))
+	assert.Zero(t, sentence)
+	var unexpectedAddressError *nmea.UnexpectedAddressError
+	assert.True(t, errors.As(err, &unexpectedAddressError))
+}
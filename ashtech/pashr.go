@@ -0,0 +1,77 @@
+package ashtech
+
+import "github.com/twpayne/go-nmea"
+
+// PASHR GNSS qualities.
+const (
+	GNSSQualityNoPosition = 0
+	GNSSQualityNonRTK     = 1
+	GNSSQualityRTK        = 2
+)
+
+// PASHR INS statuses.
+const (
+	INSStatusPreAlignment  = 0
+	INSStatusPostAlignment = 1
+)
+
+// A PASHR is an attitude sentence. Angles and their accuracies are in
+// degrees and heave is in meters. Some devices, including many inertial
+// measurement units, omit the accuracy, quality, and status fields or leave
+// them empty.
+type PASHR struct {
+	nmea.Address
+	TimeOfDay       nmea.TimeOfDay
+	Heading         float64
+	Roll            float64
+	Pitch           float64
+	Heave           nmea.Optional[float64]
+	RollAccuracy    nmea.Optional[float64]
+	PitchAccuracy   nmea.Optional[float64]
+	HeadingAccuracy nmea.Optional[float64]
+	GNSSQuality     nmea.Optional[int]
+	INSStatus       nmea.Optional[int]
+}
+
+func ParsePASHR(addr string, tok *nmea.Tokenizer) (*PASHR, error) {
+	var p PASHR
+	p.Address = nmea.NewAddress(addr)
+	p.TimeOfDay = tok.CommaTimeOfDay()
+	p.Heading = tok.CommaUnsignedFloat()
+	tok.CommaLiteralByte('T')
+	p.Roll = tok.CommaFloat()
+	p.Pitch = tok.CommaFloat()
+	p.Heave = tok.CommaOptionalFloat()
+	if !tok.AtEndOfData() {
+		p.RollAccuracy = tok.CommaOptionalUnsignedFloat()
+		p.PitchAccuracy = tok.CommaOptionalUnsignedFloat()
+		p.HeadingAccuracy = tok.CommaOptionalUnsignedFloat()
+	}
+	if !tok.AtEndOfData() {
+		p.GNSSQuality = tok.CommaOptionalUnsignedInt()
+		p.INSStatus = tok.CommaOptionalUnsignedInt()
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PASHR) Encode(b *nmea.Builder) {
+	b.CommaTimeOfDay(p.TimeOfDay, 3)
+	b.CommaFloat(p.Heading, 2)
+	b.CommaByte('T')
+	b.CommaFloat(p.Roll, 2)
+	b.CommaFloat(p.Pitch, 2)
+	b.CommaOptionalFloat(p.Heave, 2)
+	if !p.RollAccuracy.Valid && !p.PitchAccuracy.Valid && !p.HeadingAccuracy.Valid &&
+		!p.GNSSQuality.Valid && !p.INSStatus.Valid {
+		return
+	}
+	b.CommaOptionalFloat(p.RollAccuracy, 3)
+	b.CommaOptionalFloat(p.PitchAccuracy, 3)
+	b.CommaOptionalFloat(p.HeadingAccuracy, 3)
+	if !p.GNSSQuality.Valid && !p.INSStatus.Valid {
+		return
+	}
+	b.CommaOptionalInt(p.GNSSQuality)
+	b.CommaOptionalInt(p.INSStatus)
+}
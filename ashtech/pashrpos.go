@@ -0,0 +1,74 @@
+package ashtech
+
+import "github.com/twpayne/go-nmea"
+
+// PASHR,POS position modes.
+const (
+	PositionModeAutonomous   = 0
+	PositionModeDifferential = 1
+	PositionModeRTKFloat     = 2
+	PositionModeRTKFixed     = 3
+)
+
+// A PASHRPOS is a position sentence. Alt is the height above the ellipsoid
+// in meters, CourseOverGround is in degrees, SpeedOverGroundKnots is in
+// knots, and VerticalVelocity is in m/s.
+type PASHRPOS struct {
+	nmea.Address
+	PositionMode         int
+	NumberOfSatellites   int
+	TimeOfDay            nmea.TimeOfDay
+	Lat                  float64
+	Lon                  float64
+	Alt                  float64
+	AgeOfCorrections     nmea.Optional[float64]
+	CourseOverGround     float64
+	SpeedOverGroundKnots float64
+	VerticalVelocity     float64
+	PDOP                 nmea.Optional[float64]
+	HDOP                 nmea.Optional[float64]
+	VDOP                 nmea.Optional[float64]
+	TDOP                 nmea.Optional[float64]
+	FirmwareVersion      string
+}
+
+func ParsePASHRPOS(addr string, tok *nmea.Tokenizer) (*PASHRPOS, error) {
+	var p PASHRPOS
+	p.Address = nmea.NewAddress(addr)
+	p.PositionMode = tok.CommaUnsignedInt()
+	p.NumberOfSatellites = tok.CommaUnsignedInt()
+	p.TimeOfDay = tok.CommaTimeOfDay()
+	p.Lat = tok.CommaLatDegMinCommaHemi()
+	p.Lon = tok.CommaLonDegMinCommaHemi()
+	p.Alt = tok.CommaFloat()
+	p.AgeOfCorrections = tok.CommaOptionalUnsignedFloat()
+	p.CourseOverGround = tok.CommaUnsignedFloat()
+	p.SpeedOverGroundKnots = tok.CommaUnsignedFloat()
+	p.VerticalVelocity = tok.CommaFloat()
+	p.PDOP = tok.CommaOptionalUnsignedFloat()
+	p.HDOP = tok.CommaOptionalUnsignedFloat()
+	p.VDOP = tok.CommaOptionalUnsignedFloat()
+	p.TDOP = tok.CommaOptionalUnsignedFloat()
+	p.FirmwareVersion = tok.CommaString()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PASHRPOS) Encode(b *nmea.Builder) {
+	b.CommaString("POS")
+	b.CommaInt(p.PositionMode)
+	b.CommaZeroPaddedInt(p.NumberOfSatellites, 2)
+	b.CommaTimeOfDay(p.TimeOfDay, 2)
+	b.CommaLatDegMinCommaHemi(p.Lat, 6)
+	b.CommaLonDegMinCommaHemi(p.Lon, 6)
+	b.CommaFloat(p.Alt, 3)
+	b.CommaOptionalFloat(p.AgeOfCorrections, 1)
+	b.CommaFloat(p.CourseOverGround, 1)
+	b.CommaFloat(p.SpeedOverGroundKnots, 1)
+	b.CommaFloat(p.VerticalVelocity, 1)
+	b.CommaOptionalFloat(p.PDOP, 1)
+	b.CommaOptionalFloat(p.HDOP, 1)
+	b.CommaOptionalFloat(p.VDOP, 1)
+	b.CommaOptionalFloat(p.TDOP, 1)
+	b.CommaString(p.FirmwareVersion)
+}
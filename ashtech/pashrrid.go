@@ -0,0 +1,38 @@
+package ashtech
+
+import "github.com/twpayne/go-nmea"
+
+// A PASHRRID is a receiver identification sentence.
+type PASHRRID struct {
+	nmea.Address
+	ReceiverType    string
+	ChannelVersion  string
+	FirmwareVersion string
+	Options         string
+	BootVersion     nmea.Optional[string]
+}
+
+func ParsePASHRRID(addr string, tok *nmea.Tokenizer) (*PASHRRID, error) {
+	var p PASHRRID
+	p.Address = nmea.NewAddress(addr)
+	p.ReceiverType = tok.CommaString()
+	p.ChannelVersion = tok.CommaString()
+	p.FirmwareVersion = tok.CommaString()
+	p.Options = tok.CommaString()
+	if !tok.AtEndOfData() {
+		p.BootVersion = tok.CommaOptionalString()
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PASHRRID) Encode(b *nmea.Builder) {
+	b.CommaString("RID")
+	b.CommaString(p.ReceiverType)
+	b.CommaString(p.ChannelVersion)
+	b.CommaString(p.FirmwareVersion)
+	b.CommaString(p.Options)
+	if p.BootVersion.Valid {
+		b.CommaString(p.BootVersion.Value)
+	}
+}
@@ -0,0 +1,55 @@
+package ashtech
+
+import "github.com/twpayne/go-nmea"
+
+// A SatelliteStatus is the status of a satellite in a PASHR,SAT sentence.
+// Azimuth and Elevation are in degrees and SNR is in dB-Hz.
+type SatelliteStatus struct {
+	PRN       int
+	Azimuth   int
+	Elevation int
+	SNR       int
+	Used      bool
+}
+
+// A PASHRSAT is a satellite status sentence.
+type PASHRSAT struct {
+	nmea.Address
+	SatelliteStatuses []SatelliteStatus
+}
+
+func ParsePASHRSAT(addr string, tok *nmea.Tokenizer) (*PASHRSAT, error) {
+	var p PASHRSAT
+	p.Address = nmea.NewAddress(addr)
+	// The number of satellites is untrusted, so do not preallocate from it
+	// and stop at the first error.
+	n := tok.CommaUnsignedInt()
+	for i := 0; i < n && tok.Err() == nil; i++ {
+		satelliteStatus := SatelliteStatus{
+			PRN:       tok.CommaUnsignedInt(),
+			Azimuth:   tok.CommaUnsignedInt(),
+			Elevation: tok.CommaUnsignedInt(),
+			SNR:       tok.CommaUnsignedInt(),
+			Used:      tok.CommaOneByteOf("U-") == 'U',
+		}
+		p.SatelliteStatuses = append(p.SatelliteStatuses, satelliteStatus)
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PASHRSAT) Encode(b *nmea.Builder) {
+	b.CommaString("SAT")
+	b.CommaZeroPaddedInt(len(p.SatelliteStatuses), 2)
+	for _, satelliteStatus := range p.SatelliteStatuses {
+		b.CommaZeroPaddedInt(satelliteStatus.PRN, 2)
+		b.CommaZeroPaddedInt(satelliteStatus.Azimuth, 3)
+		b.CommaZeroPaddedInt(satelliteStatus.Elevation, 2)
+		b.CommaZeroPaddedInt(satelliteStatus.SNR, 2)
+		if satelliteStatus.Used {
+			b.CommaByte('U')
+		} else {
+			b.CommaByte('-')
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package ashtech
+
+import "github.com/twpayne/go-nmea"
+
+// BaudRates maps PASHS,SPD baud rate codes to baud rates.
+var BaudRates = map[int]int{
+	0: 300,
+	1: 600,
+	2: 1200,
+	3: 2400,
+	4: 4800,
+	5: 9600,
+	6: 19200,
+	7: 38400,
+	8: 57600,
+	9: 115200,
+}
+
+// A PASHSELM sets the elevation mask, in degrees.
+type PASHSELM struct {
+	nmea.Address
+	ElevationMask int
+}
+
+// A PASHSNME enables or disables the output of an NMEA sentence, for example
+// GGA or ALL, on a port, for example A.
+type PASHSNME struct {
+	nmea.Address
+	Sentence string
+	Port     byte
+	On       bool
+}
+
+// A PASHSRST resets the receiver to its default configuration.
+type PASHSRST struct {
+	nmea.Address
+}
+
+// A PASHSSPD sets the baud rate of a port. BaudRateCode is a key of
+// BaudRates.
+type PASHSSPD struct {
+	nmea.Address
+	Port         byte
+	BaudRateCode int
+}
+
+func ParsePASHSELM(addr string, tok *nmea.Tokenizer) (*PASHSELM, error) {
+	var p PASHSELM
+	p.Address = nmea.NewAddress(addr)
+	p.ElevationMask = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func ParsePASHSNME(addr string, tok *nmea.Tokenizer) (*PASHSNME, error) {
+	var p PASHSNME
+	p.Address = nmea.NewAddress(addr)
+	p.Sentence = tok.CommaString()
+	p.Port = tok.CommaOneByteOf("ABCD")
+	tok.CommaLiteralByte('O')
+	p.On = tok.OneByteOf("NF") == 'N'
+	if !p.On {
+		tok.LiteralByte('F')
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func ParsePASHSRST(addr string, tok *nmea.Tokenizer) (*PASHSRST, error) {
+	var p PASHSRST
+	p.Address = nmea.NewAddress(addr)
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func ParsePASHSSPD(addr string, tok *nmea.Tokenizer) (*PASHSSPD, error) {
+	var p PASHSSPD
+	p.Address = nmea.NewAddress(addr)
+	p.Port = tok.CommaOneByteOf("ABCD")
+	p.BaudRateCode = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PASHSELM) Encode(b *nmea.Builder) {
+	b.CommaString("ELM")
+	b.CommaInt(p.ElevationMask)
+}
+
+func (p *PASHSNME) Encode(b *nmea.Builder) {
+	b.CommaString("NME")
+	b.CommaString(p.Sentence)
+	b.CommaByte(p.Port)
+	if p.On {
+		b.CommaString("ON")
+	} else {
+		b.CommaString("OFF")
+	}
+}
+
+func (p *PASHSRST) Encode(b *nmea.Builder) {
+	b.CommaString("RST")
+}
+
+func (p *PASHSSPD) Encode(b *nmea.Builder) {
+	b.CommaString("SPD")
+	b.CommaByte(p.Port)
+	b.CommaInt(p.BaudRateCode)
+}
@@ -0,0 +1,388 @@
+package nmea
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strconv"
+)
+
+var ErrReservedCharacter = errors.New("reserved character")
+
+// A SentenceEncoder is a Sentence that can be encoded.
+type SentenceEncoder interface {
+	Sentence
+	Encode(b *Builder)
+}
+
+// A Builder builds a sentence's fields. It is the inverse of a Tokenizer.
+//
+// Like a Tokenizer, a Builder has a sticky error: once an error occurs all
+// subsequent method calls do nothing and the error is returned by Err.
+type Builder struct {
+	data []byte
+	err  error
+}
+
+// A StartDelimiterer is a SentenceEncoder whose sentences start with a
+// delimiter other than '$', for example '!'.
+type StartDelimiterer interface {
+	SentenceEncoder
+	StartDelimiter() byte
+}
+
+// Append appends the sentence s, including its start delimiter, checksum,
+// and line ending, to data.
+func Append(data []byte, s SentenceEncoder) ([]byte, error) {
+	start := len(data)
+	startDelimiter := byte('$')
+	if s, ok := s.(StartDelimiterer); ok {
+		startDelimiter = s.StartDelimiter()
+	}
+	b := Builder{
+		data: append(data, startDelimiter),
+	}
+	b.data = append(b.data, s.GetAddress().String()...)
+	s.Encode(&b)
+	if b.err != nil {
+		return data, b.err
+	}
+	return AppendChecksum(b.data, start), nil
+}
+
+// AppendChecksum appends the checksum of the sentence starting at
+// data[start], which must be a start delimiter, and a line ending to data.
+func AppendChecksum(data []byte, start int) []byte {
+	var checksum byte
+	for _, c := range data[start+1:] {
+		checksum ^= c
+	}
+	const hexDigits = "0123456789ABCDEF"
+	return append(data, '*', hexDigits[checksum>>4], hexDigits[checksum&0xf], '\r', '\n')
+}
+
+// Marshal returns the sentence s, including its start delimiter, checksum,
+// and line ending.
+func Marshal(s SentenceEncoder) ([]byte, error) {
+	return Append(nil, s)
+}
+
+func NewBuilder(data []byte) *Builder {
+	return &Builder{
+		data: data,
+	}
+}
+
+func (b *Builder) Bytes() []byte {
+	return b.data
+}
+
+func (b *Builder) Comma() {
+	if b.err != nil {
+		return
+	}
+	b.data = append(b.data, ',')
+}
+
+func (b *Builder) CommaByte(c byte) {
+	b.Comma()
+	b.Byte(c)
+}
+
+func (b *Builder) CommaDate(date Date) {
+	b.Comma()
+	b.Date(date)
+}
+
+func (b *Builder) CommaFloat(value float64, prec int) {
+	b.Comma()
+	b.Float(value, prec)
+}
+
+func (b *Builder) CommaFloatCommaUnit(value float64, prec int, unit byte) {
+	b.CommaFloat(value, prec)
+	b.CommaByte(unit)
+}
+
+func (b *Builder) CommaHex(value, digits int) {
+	b.Comma()
+	b.Hex(value, digits)
+}
+
+func (b *Builder) CommaInt(value int) {
+	b.Comma()
+	b.Int(value)
+}
+
+func (b *Builder) CommaLatDegMinCommaHemi(lat float64, prec int) {
+	b.Comma()
+	b.LatDegMinCommaHemi(lat, prec)
+}
+
+func (b *Builder) CommaLonDegMinCommaHemi(lon float64, prec int) {
+	b.Comma()
+	b.LonDegMinCommaHemi(lon, prec)
+}
+
+func (b *Builder) CommaOptionalByte(c Optional[byte]) {
+	b.Comma()
+	if c.Valid {
+		b.Byte(c.Value)
+	}
+}
+
+func (b *Builder) CommaOptionalDate(date Optional[Date]) {
+	b.Comma()
+	if date.Valid {
+		b.Date(date.Value)
+	}
+}
+
+func (b *Builder) CommaOptionalFloat(value Optional[float64], prec int) {
+	b.Comma()
+	if value.Valid {
+		b.Float(value.Value, prec)
+	}
+}
+
+func (b *Builder) CommaOptionalFloatCommaUnit(value Optional[float64], prec int, unit byte) {
+	b.CommaOptionalFloat(value, prec)
+	b.Comma()
+	if value.Valid {
+		b.Byte(unit)
+	}
+}
+
+func (b *Builder) CommaOptionalInt(value Optional[int]) {
+	b.Comma()
+	if value.Valid {
+		b.Int(value.Value)
+	}
+}
+
+func (b *Builder) CommaOptionalLatDegMinCommaHemi(lat Optional[float64], prec int) {
+	if lat.Valid {
+		b.CommaLatDegMinCommaHemi(lat.Value, prec)
+	} else {
+		b.Comma()
+		b.Comma()
+	}
+}
+
+func (b *Builder) CommaOptionalLonDegMinCommaHemi(lon Optional[float64], prec int) {
+	if lon.Valid {
+		b.CommaLonDegMinCommaHemi(lon.Value, prec)
+	} else {
+		b.Comma()
+		b.Comma()
+	}
+}
+
+func (b *Builder) CommaOptionalString(s Optional[string]) {
+	b.Comma()
+	if s.Valid {
+		b.String(s.Value)
+	}
+}
+
+func (b *Builder) CommaOptionalTimeOfDay(timeOfDay Optional[TimeOfDay], prec int) {
+	b.Comma()
+	if timeOfDay.Valid {
+		b.TimeOfDay(timeOfDay.Value, prec)
+	}
+}
+
+func (b *Builder) CommaString(s string) {
+	b.Comma()
+	b.String(s)
+}
+
+func (b *Builder) CommaTimeOfDay(timeOfDay TimeOfDay, prec int) {
+	b.Comma()
+	b.TimeOfDay(timeOfDay, prec)
+}
+
+func (b *Builder) CommaZeroPaddedInt(value, digits int) {
+	b.Comma()
+	b.ZeroPaddedInt(value, digits)
+}
+
+func (b *Builder) Byte(c byte) {
+	if b.err != nil {
+		return
+	}
+	if isReserved(c) {
+		b.err = ErrReservedCharacter
+		return
+	}
+	b.data = append(b.data, c)
+}
+
+func (b *Builder) Date(date Date) {
+	b.ZeroPaddedInt(date.Day, 2)
+	b.ZeroPaddedInt(int(date.Month), 2)
+	b.ZeroPaddedInt(date.Year%100, 2)
+}
+
+func (b *Builder) Err() error {
+	return b.err
+}
+
+// Float appends value with prec digits after the decimal point. If prec is
+// negative then the minimum number of digits necessary to represent value
+// exactly is used.
+func (b *Builder) Float(value float64, prec int) {
+	if b.err != nil {
+		return
+	}
+	b.data = strconv.AppendFloat(b.data, value, 'f', prec, 64)
+}
+
+// Hex appends value, which must be non-negative, as uppercase hexadecimal,
+// zero-padded to digits.
+func (b *Builder) Hex(value, digits int) {
+	if b.err != nil {
+		return
+	}
+	start := len(b.data)
+	b.data = strconv.AppendUint(b.data, uint64(value), 16)
+	b.pad(start, digits)
+	for i := start; i < len(b.data); i++ {
+		if 'a' <= b.data[i] && b.data[i] <= 'f' {
+			b.data[i] -= 'a' - 'A'
+		}
+	}
+}
+
+func (b *Builder) Int(value int) {
+	if b.err != nil {
+		return
+	}
+	b.data = strconv.AppendInt(b.data, int64(value), 10)
+}
+
+// LatDegMinCommaHemi appends lat in ddmm.mmm format, with prec digits after
+// the decimal point, followed by a comma and the hemisphere. If prec is
+// negative then the minimum number of digits is used.
+func (b *Builder) LatDegMinCommaHemi(lat float64, prec int) {
+	b.degMin(math.Abs(lat), 2, prec)
+	if lat < 0 {
+		b.CommaByte('S')
+	} else {
+		b.CommaByte('N')
+	}
+}
+
+// LonDegMinCommaHemi appends lon in dddmm.mmm format, with prec digits after
+// the decimal point, followed by a comma and the hemisphere. If prec is
+// negative then the minimum number of digits is used.
+func (b *Builder) LonDegMinCommaHemi(lon float64, prec int) {
+	b.degMin(math.Abs(lon), 3, prec)
+	if lon < 0 {
+		b.CommaByte('W')
+	} else {
+		b.CommaByte('E')
+	}
+}
+
+// String appends s. It is an error if s contains a reserved character.
+func (b *Builder) String(s string) {
+	if b.err != nil {
+		return
+	}
+	for i := 0; i < len(s); i++ {
+		if isReserved(s[i]) {
+			b.err = ErrReservedCharacter
+			return
+		}
+	}
+	b.data = append(b.data, s...)
+}
+
+// TimeOfDay appends timeOfDay in hhmmss.ss format, with prec digits after
+// the decimal point. The fractional part of the second is truncated. prec is
+// limited to nine digits, the resolution of a TimeOfDay.
+func (b *Builder) TimeOfDay(timeOfDay TimeOfDay, prec int) {
+	b.ZeroPaddedInt(timeOfDay.Hour, 2)
+	b.ZeroPaddedInt(timeOfDay.Minute, 2)
+	b.ZeroPaddedInt(timeOfDay.Second, 2)
+	if prec <= 0 || b.err != nil {
+		return
+	}
+	prec = min(prec, 9)
+	b.data = append(b.data, '.')
+	fraction := timeOfDay.Nanosecond
+	for i := prec; i < 9; i++ {
+		fraction /= 10
+	}
+	b.ZeroPaddedInt(fraction, prec)
+}
+
+// ZeroPaddedInt appends value zero-padded to digits.
+func (b *Builder) ZeroPaddedInt(value, digits int) {
+	if b.err != nil {
+		return
+	}
+	if value < 0 {
+		b.data = append(b.data, '-')
+		value = -value
+		digits--
+	}
+	start := len(b.data)
+	b.data = strconv.AppendInt(b.data, int64(value), 10)
+	b.pad(start, digits)
+}
+
+func (b *Builder) degMin(value float64, degDigits, prec int) {
+	if b.err != nil {
+		return
+	}
+	deg := math.Floor(value)
+	min := (value - deg) * 60
+	if prec >= 0 {
+		scale := math.Pow10(prec)
+		min = math.Round(min*scale) / scale
+	} else {
+		// Round to the precision of a float64 to avoid noise like
+		// 17.099999999999994.
+		min, _ = strconv.ParseFloat(strconv.FormatFloat(min, 'f', 12, 64), 64)
+	}
+	if min >= 60 {
+		deg++
+		min -= 60
+	}
+	b.ZeroPaddedInt(int(deg), degDigits)
+	start := len(b.data)
+	b.data = strconv.AppendFloat(b.data, min, 'f', prec, 64)
+	if i := bytes.IndexByte(b.data[start:], '.'); i != -1 {
+		b.pad(start, len(b.data)-start-i+2)
+	} else {
+		b.pad(start, 2)
+	}
+}
+
+// pad left-pads the digits from b.data[start:] with zeros to width digits.
+func (b *Builder) pad(start, digits int) {
+	n := digits - (len(b.data) - start)
+	if n <= 0 {
+		return
+	}
+	for i := 0; i < n; i++ {
+		b.data = append(b.data, '0')
+	}
+	copy(b.data[start+n:], b.data[start:len(b.data)-n])
+	for i := start; i < start+n; i++ {
+		b.data[i] = '0'
+	}
+}
+
+// isReserved returns if c is a reserved character that cannot appear in a
+// field.
+func isReserved(c byte) bool {
+	switch c {
+	case '\r', '\n', '!', '$', '*', ',', '\\', '^', '~':
+		return true
+	default:
+		return c < 0x20 || 0x7e < c
+	}
+}
@@ -0,0 +1,135 @@
+package nmea_test
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+)
+
+func TestBuilder(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		build    func(*nmea.Builder)
+		expected string
+	}{
+		{
+			name: "float",
+			build: func(b *nmea.Builder) {
+				b.Float(1.25, 1)
+				b.CommaFloat(1.25, -1)
+				b.CommaOptionalFloat(nmea.Optional[float64]{}, 2)
+			},
+			expected: "1.2,1.25,",
+		},
+		{
+			name: "hex",
+			build: func(b *nmea.Builder) {
+				b.Hex(0xab, 4)
+			},
+			expected: "00AB",
+		},
+		{
+			name: "lat_lon",
+			build: func(b *nmea.Builder) {
+				b.LatDegMinCommaHemi(47.285233166666664, 5)
+				b.CommaLonDegMinCommaHemi(-8.565265, 5)
+			},
+			expected: "4717.11399,N,00833.91590,W",
+		},
+		{
+			name: "lat_lon_shortest",
+			build: func(b *nmea.Builder) {
+				b.LatDegMinCommaHemi(-36.775666666666666, -1)
+				b.CommaLonDegMinCommaHemi(2.085, -1)
+			},
+			expected: "3646.54,S,00205.1,E",
+		},
+		{
+			name: "lat_round_up",
+			build: func(b *nmea.Builder) {
+				b.LatDegMinCommaHemi(47.9999999, 2)
+			},
+			expected: "4800.00,N",
+		},
+		{
+			name: "time_of_day",
+			build: func(b *nmea.Builder) {
+				b.TimeOfDay(nmea.TimeOfDay{Hour: 9, Minute: 2, Second: 3, Nanosecond: 456789000}, 2)
+				b.CommaTimeOfDay(nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 60}, 0)
+			},
+			expected: "090203.45,235960",
+		},
+		{
+			name: "time_of_day_max_prec",
+			build: func(b *nmea.Builder) {
+				b.TimeOfDay(nmea.TimeOfDay{Nanosecond: 500000000}, 12)
+			},
+			expected: "000000.500000000",
+		},
+		{
+			name: "date",
+			build: func(b *nmea.Builder) {
+				b.Date(nmea.Date{Year: 2009, Month: 1, Day: 2})
+			},
+			expected: "020109",
+		},
+		{
+			name: "zero_padded_int",
+			build: func(b *nmea.Builder) {
+				b.ZeroPaddedInt(7, 3)
+				b.CommaZeroPaddedInt(-7, 3)
+			},
+			expected: "007,-07",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := nmea.NewBuilder(nil)
+			tc.build(b)
+			assert.NoError(t, b.Err())
+			assert.Equal(t, tc.expected, string(b.Bytes()))
+		})
+	}
+}
+
+func TestBuilder_ReservedCharacter(t *testing.T) {
+	b := nmea.NewBuilder(nil)
+	b.String("a*b")
+	b.CommaString("c")
+	assert.IsError(t, b.Err(), nmea.ErrReservedCharacter)
+	assert.Equal(t, "", string(b.Bytes()))
+}
+
+func TestMarshal(t *testing.T) {
+	u := &nmea.Unknown{
+		Address: nmea.NewAddress("GPTXT"),
+		Fields:  []string{"01"},
+	}
+	data, err := nmea.Marshal(u)
+	assert.NoError(t, err)
+	assert.Equal(t, "$GPTXT,01*62\r\n", string(data))
+
+	actual, err := nmea.NewParser().Parse(data)
+	assert.NoError(t, err)
+	assert.Equal(t, nmea.Sentence(u), actual)
+
+	encapsulated := &nmea.Unknown{
+		Address:      nmea.NewAddress("AIVDM"),
+		Encapsulated: true,
+		Fields:       []string{"1"},
+	}
+	data, err = nmea.Marshal(encapsulated)
+	assert.NoError(t, err)
+	assert.Equal(t, "!AIVDM,1*4A\r\n", string(data))
+
+	actual, err = nmea.NewParser().Parse(data)
+	assert.NoError(t, err)
+	assert.Equal(t, nmea.Sentence(encapsulated), actual)
+
+	_, err = nmea.Marshal(&nmea.Unknown{
+		Address: nmea.NewAddress("GPTXT"),
+		Fields:  []string{"$"},
+	})
+	assert.IsError(t, err, nmea.ErrReservedCharacter)
+}
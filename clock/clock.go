@@ -0,0 +1,230 @@
+// Package clock reconstructs absolute times for sentences that carry only a
+// time of day.
+//
+// A Clock learns the date from RMC, ZDA, PGRMF, and PUBX,04 sentences and
+// combines it with the time of day in GBS, GGA, GLL, GNS, and GST sentences.
+// It can optionally correct dates affected by the GPS week number rollover
+// bug in old receivers.
+package clock
+
+import (
+	"iter"
+	"time"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/garmin"
+	"github.com/twpayne/go-nmea/standard"
+	"github.com/twpayne/go-nmea/ublox"
+)
+
+// gpsWeekRollover is the period of the ten-bit GPS week number.
+const gpsWeekRollover = 1024 * 7 * 24 * time.Hour
+
+// LastGPSWeekRollover is the most recent GPS week number rollover, which is a
+// suitable reference time for live data from receivers affected by the
+// rollover bug.
+var LastGPSWeekRollover = time.Date(2019, time.April, 7, 0, 0, 0, 0, time.UTC)
+
+// defaultMaxPending is the default maximum number of sentences that
+// Timestamp holds while waiting for the date.
+const defaultMaxPending = 64
+
+// A TimedSentence is a sentence and its absolute time. Time is the zero
+// time if the sentence's time could not be determined.
+type TimedSentence struct {
+	Time     time.Time
+	Sentence nmea.Sentence
+}
+
+// A Clock reconstructs absolute times from a stream of sentences.
+type Clock struct {
+	referenceTime time.Time
+	maxPending    int
+	time          nmea.Optional[time.Time]
+	leapSeconds   nmea.Optional[int]
+}
+
+type Option func(*Clock)
+
+// WithMaxPending sets the maximum number of sentences that Timestamp holds
+// while waiting for the date. When this limit is exceeded then the held
+// sentences are yielded with zero times.
+func WithMaxPending(maxPending int) Option {
+	return func(c *Clock) {
+		c.maxPending = maxPending
+	}
+}
+
+// WithReferenceTime enables correction of the GPS week number rollover bug.
+// Dates in PGRMF and PUBX,04 sentences, which receivers derive from the GPS
+// week number, that are before referenceTime are advanced by multiples of
+// 1024 weeks until they are not before it. Dates in RMC and ZDA sentences are
+// never corrected. The default is the zero time, which disables correction,
+// so that archived logs keep their original dates.
+func WithReferenceTime(referenceTime time.Time) Option {
+	return func(c *Clock) {
+		c.referenceTime = referenceTime
+	}
+}
+
+func New(options ...Option) *Clock {
+	c := &Clock{
+		maxPending: defaultMaxPending,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// LeapSeconds returns the most recent difference between GPS time and UTC
+// reported by a PGRMF or PUBX,04 sentence.
+func (c *Clock) LeapSeconds() nmea.Optional[int] {
+	return c.leapSeconds
+}
+
+// Time updates c with sentence and returns sentence's absolute time. If
+// sentence does not contain a time then it returns the most recent time.
+// It returns false if the date is not yet known.
+//
+// A time of day is combined with the date of the most recent time, moved by
+// a day if that brings it within twelve hours of the most recent time, to
+// handle midnight crossings and sentences delayed across midnight. Times
+// during a leap second, when the second is 60, are reported as the last
+// nanosecond of the preceding second so that times do not go backwards.
+func (c *Clock) Time(sentence nmea.Sentence) (time.Time, bool) {
+	switch s := sentence.(type) {
+	case *garmin.PGRMF:
+		c.leapSeconds = nmea.NewOptional(s.LeapSeconds)
+		return c.setDateTime(c.correctRollover(s.Time))
+	case *standard.RMC:
+		if s.Date.Valid && s.TimeOfDay.Valid {
+			return c.setDateTime(s.Date.Value.At(s.TimeOfDay.Value))
+		}
+	case *standard.ZDA:
+		return c.setDateTime(s.Time)
+	case *ublox.Time:
+		if !s.LeapSecondsDefault {
+			c.leapSeconds = nmea.NewOptional(s.LeapSeconds)
+		}
+		return c.setDateTime(c.correctRollover(s.Time))
+	case *standard.GBS:
+		return c.setTimeOfDay(s.TimeOfDay)
+	case *standard.GGA:
+		if s.TimeOfDay.Valid {
+			return c.setTimeOfDay(s.TimeOfDay.Value)
+		}
+	case *standard.GLL:
+		return c.setTimeOfDay(s.TimeOfDay)
+	case *standard.GNS:
+		return c.setTimeOfDay(s.TimeOfDay)
+	case *standard.GST:
+		return c.setTimeOfDay(s.TimeOfDay)
+	}
+	return c.time.Value, c.time.Valid
+}
+
+// Timestamp returns an iterator over the sentences in seq with their
+// absolute times. Sentences that precede the first date are held until the
+// date is known. Errors are yielded in order with zero TimedSentences.
+func (c *Clock) Timestamp(seq iter.Seq2[nmea.Sentence, error]) iter.Seq2[TimedSentence, error] {
+	type pendingSentence struct {
+		sentence nmea.Sentence
+		err      error
+	}
+	return func(yield func(TimedSentence, error) bool) {
+		var pending []pendingSentence
+		flush := func() bool {
+			for _, p := range pending {
+				var timedSentence TimedSentence
+				if p.err == nil {
+					timedSentence.Sentence = p.sentence
+					if t, ok := c.Time(p.sentence); ok {
+						timedSentence.Time = t
+					}
+				}
+				if !yield(timedSentence, p.err) {
+					return false
+				}
+			}
+			pending = pending[:0]
+			return true
+		}
+		for sentence, err := range seq {
+			if !c.time.Valid {
+				pending = append(pending, pendingSentence{sentence: sentence, err: err})
+				if err == nil && c.dateOf(sentence).Valid {
+					// Learn the date so that the held sentences, including
+					// this one, are timed relative to it.
+					c.Time(sentence)
+					if !flush() {
+						return
+					}
+				} else if len(pending) > c.maxPending {
+					if !flush() {
+						return
+					}
+				}
+				continue
+			}
+			var timedSentence TimedSentence
+			if err == nil {
+				timedSentence.Sentence = sentence
+				timedSentence.Time, _ = c.Time(sentence)
+			}
+			if !yield(timedSentence, err) {
+				return
+			}
+		}
+		flush()
+	}
+}
+
+// correctRollover returns t advanced by multiples of 1024 weeks until it is
+// not before c's reference time.
+func (c *Clock) correctRollover(t time.Time) time.Time {
+	if c.referenceTime.IsZero() {
+		return t
+	}
+	for t.Before(c.referenceTime) {
+		t = t.Add(gpsWeekRollover)
+	}
+	return t
+}
+
+// dateOf returns the date and time in sentence, if any.
+func (c *Clock) dateOf(sentence nmea.Sentence) nmea.Optional[time.Time] {
+	switch s := sentence.(type) {
+	case *garmin.PGRMF:
+		return nmea.NewOptional(s.Time)
+	case *standard.RMC:
+		return s.Time()
+	case *standard.ZDA:
+		return nmea.NewOptional(s.Time)
+	case *ublox.Time:
+		return nmea.NewOptional(s.Time)
+	default:
+		return nmea.Optional[time.Time]{}
+	}
+}
+
+func (c *Clock) setDateTime(t time.Time) (time.Time, bool) {
+	c.time = nmea.NewOptional(t)
+	return t, true
+}
+
+func (c *Clock) setTimeOfDay(timeOfDay nmea.TimeOfDay) (time.Time, bool) {
+	if !c.time.Valid {
+		return time.Time{}, false
+	}
+	year, month, day := c.time.Value.Date()
+	t := nmea.Date{Year: year, Month: month, Day: day}.At(timeOfDay)
+	switch delta := t.Sub(c.time.Value); {
+	case delta < -12*time.Hour:
+		t = t.AddDate(0, 0, 1)
+	case delta > 12*time.Hour:
+		t = t.AddDate(0, 0, -1)
+	}
+	c.time = nmea.NewOptional(t)
+	return t, true
+}
@@ -0,0 +1,242 @@
+package clock_test
+
+import (
+	"errors"
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/clock"
+	"github.com/twpayne/go-nmea/garmin"
+	"github.com/twpayne/go-nmea/standard"
+	"github.com/twpayne/go-nmea/ublox"
+)
+
+func gga(hour, minute, second, nanosecond int) *standard.GGA {
+	return &standard.GGA{
+		Address: nmea.NewAddress("GPGGA"),
+		TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+			Hour:       hour,
+			Minute:     minute,
+			Second:     second,
+			Nanosecond: nanosecond,
+		}),
+	}
+}
+
+func rmc(year int, month time.Month, day, hour, minute, second int) *standard.RMC {
+	return &standard.RMC{
+		Address: nmea.NewAddress("GPRMC"),
+		TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+			Hour:   hour,
+			Minute: minute,
+			Second: second,
+		}),
+		Status: 'A',
+		Date: nmea.NewOptional(nmea.Date{
+			Year:  year,
+			Month: month,
+			Day:   day,
+		}),
+	}
+}
+
+func seq(items ...any) iter.Seq2[nmea.Sentence, error] {
+	return func(yield func(nmea.Sentence, error) bool) {
+		for _, item := range items {
+			var ok bool
+			switch item := item.(type) {
+			case error:
+				ok = yield(nil, item)
+			case nmea.Sentence:
+				ok = yield(item, nil)
+			}
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+func TestClockTime(t *testing.T) {
+	c := clock.New()
+
+	_, ok := c.Time(gga(12, 0, 0, 0))
+	assert.False(t, ok)
+
+	actual, ok := c.Time(rmc(2024, time.May, 1, 23, 59, 58))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, time.May, 1, 23, 59, 58, 0, time.UTC), actual)
+
+	for _, tc := range []struct {
+		name     string
+		sentence nmea.Sentence
+		expected time.Time
+	}{
+		{
+			name:     "same_day",
+			sentence: gga(23, 59, 59, 500000000),
+			expected: time.Date(2024, time.May, 1, 23, 59, 59, 500000000, time.UTC),
+		},
+		{
+			name:     "leap_second",
+			sentence: gga(23, 59, 60, 0),
+			expected: time.Date(2024, time.May, 1, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			name: "midnight_crossing",
+			sentence: &standard.GLL{
+				TimeOfDay: nmea.TimeOfDay{Second: 1},
+				Status:    'A',
+			},
+			expected: time.Date(2024, time.May, 2, 0, 0, 1, 0, time.UTC),
+		},
+		{
+			name:     "delayed_across_midnight",
+			sentence: &standard.GST{TimeOfDay: nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 59}},
+			expected: time.Date(2024, time.May, 1, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:     "gbs",
+			sentence: &standard.GBS{TimeOfDay: nmea.TimeOfDay{Second: 2}},
+			expected: time.Date(2024, time.May, 2, 0, 0, 2, 0, time.UTC),
+		},
+		{
+			name:     "no_time",
+			sentence: &standard.TXT{},
+			expected: time.Date(2024, time.May, 2, 0, 0, 2, 0, time.UTC),
+		},
+		{
+			name:     "no_rollover_correction",
+			sentence: rmc(2015, time.March, 23, 12, 35, 19),
+			expected: time.Date(2015, time.March, 23, 12, 35, 19, 0, time.UTC),
+		},
+		{
+			name: "no_rollover_correction_pgrmf",
+			sentence: &garmin.PGRMF{
+				Time: time.Date(2005, time.March, 16, 9, 38, 2, 0, time.UTC),
+			},
+			expected: time.Date(2005, time.March, 16, 9, 38, 2, 0, time.UTC),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, ok := c.Time(tc.sentence)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestClockLeapSeconds(t *testing.T) {
+	c := clock.New()
+	assert.False(t, c.LeapSeconds().Valid)
+
+	c.Time(&ublox.Time{
+		Time:               time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+		LeapSeconds:        15,
+		LeapSecondsDefault: true,
+	})
+	assert.False(t, c.LeapSeconds().Valid)
+
+	c.Time(&ublox.Time{
+		Time:        time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+		LeapSeconds: 18,
+	})
+	assert.Equal(t, nmea.NewOptional(18), c.LeapSeconds())
+
+	actual, ok := c.Time(&garmin.PGRMF{
+		Time:        time.Date(2005, time.March, 16, 9, 38, 2, 0, time.UTC),
+		LeapSeconds: 13,
+	})
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2005, time.March, 16, 9, 38, 2, 0, time.UTC), actual)
+	assert.Equal(t, nmea.NewOptional(13), c.LeapSeconds())
+}
+
+func TestClockWithReferenceTime(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		sentence nmea.Sentence
+		expected time.Time
+	}{
+		{
+			name: "pgrmf",
+			sentence: &garmin.PGRMF{
+				Time: time.Date(2005, time.March, 16, 9, 38, 2, 0, time.UTC),
+			},
+			expected: time.Date(2024, time.October, 30, 9, 38, 2, 0, time.UTC),
+		},
+		{
+			name: "pubx_04",
+			sentence: &ublox.Time{
+				Time: time.Date(1999, time.August, 22, 0, 0, 0, 0, time.UTC),
+			},
+			expected: time.Date(2019, time.April, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "after_reference_time",
+			sentence: &ublox.Time{Time: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)},
+			expected: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "rmc",
+			sentence: rmc(2015, time.March, 23, 12, 35, 19),
+			expected: time.Date(2015, time.March, 23, 12, 35, 19, 0, time.UTC),
+		},
+		{
+			name:     "zda",
+			sentence: &standard.ZDA{Time: time.Date(2004, time.September, 17, 12, 0, 0, 0, time.UTC)},
+			expected: time.Date(2004, time.September, 17, 12, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := clock.New(clock.WithReferenceTime(clock.LastGPSWeekRollover))
+			actual, ok := c.Time(tc.sentence)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestClockTimestamp(t *testing.T) {
+	errParse := errors.New("parse error")
+	txt := &standard.TXT{}
+	var actual []clock.TimedSentence
+	var actualErrs []error
+	for timedSentence, err := range clock.New().Timestamp(seq(
+		gga(23, 59, 59, 0),
+		txt,
+		errParse,
+		rmc(2024, time.May, 2, 0, 0, 0),
+		gga(0, 0, 1, 0),
+	)) {
+		actual = append(actual, timedSentence)
+		actualErrs = append(actualErrs, err)
+	}
+	assert.Equal(t, []clock.TimedSentence{
+		{Time: time.Date(2024, time.May, 1, 23, 59, 59, 0, time.UTC), Sentence: gga(23, 59, 59, 0)},
+		{Time: time.Date(2024, time.May, 1, 23, 59, 59, 0, time.UTC), Sentence: txt},
+		{},
+		{Time: time.Date(2024, time.May, 2, 0, 0, 0, 0, time.UTC), Sentence: rmc(2024, time.May, 2, 0, 0, 0)},
+		{Time: time.Date(2024, time.May, 2, 0, 0, 1, 0, time.UTC), Sentence: gga(0, 0, 1, 0)},
+	}, actual)
+	assert.Equal(t, []error{nil, nil, errParse, nil, nil}, actualErrs)
+
+	actual = nil
+	for timedSentence, err := range clock.New(clock.WithMaxPending(1)).Timestamp(seq(
+		gga(12, 0, 0, 0),
+		gga(12, 0, 1, 0),
+		gga(12, 0, 2, 0),
+	)) {
+		assert.NoError(t, err)
+		actual = append(actual, timedSentence)
+	}
+	assert.Equal(t, []clock.TimedSentence{
+		{Sentence: gga(12, 0, 0, 0)},
+		{Sentence: gga(12, 0, 1, 0)},
+		{Sentence: gga(12, 0, 2, 0)},
+	}, actual)
+}
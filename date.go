@@ -15,6 +15,18 @@ func (d Date) String() string {
 	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
 }
 
+// At returns the time at timeOfDay on d in UTC. time.Time cannot represent
+// leap seconds, so times during a leap second are returned as the last
+// nanosecond of the preceding second rather than being normalized into the
+// following minute. Use the timescale package to convert leap seconds
+// exactly.
+func (d Date) At(timeOfDay TimeOfDay) time.Time {
+	if timeOfDay.Second == 60 {
+		return time.Date(d.Year, d.Month, d.Day, timeOfDay.Hour, timeOfDay.Minute, 59, 999999999, time.UTC)
+	}
+	return time.Date(d.Year, d.Month, d.Day, timeOfDay.Hour, timeOfDay.Minute, timeOfDay.Second, timeOfDay.Nanosecond, time.UTC)
+}
+
 func (t *Tokenizer) CommaDate() Date {
 	t.Comma()
 	return t.Date()
@@ -26,9 +38,11 @@ func (t *Tokenizer) CommaOptionalDate() Optional[Date] {
 }
 
 func (t *Tokenizer) Date() Date {
+	prev := t.expect(FieldKindDate)
 	day := t.DecimalDigits(2)
 	month := time.Month(t.DecimalDigits(2))
 	year := 1900 + t.DecimalDigits(2)
+	t.kind = prev
 	if year < 1993 {
 		year += 100
 	}
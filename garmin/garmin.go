@@ -8,14 +8,18 @@ import (
 )
 
 var sentenceParserMap = nmea.SentenceParserMap{
-	"PGRMB": nmea.MakeSentenceParser(ParsePGRMB),
-	"PGRME": nmea.MakeSentenceParser(ParsePGRME),
-	"PGRMF": nmea.MakeSentenceParser(ParsePGRMF),
-	"PGRMH": nmea.MakeSentenceParser(ParsePGRMH),
-	"PGRMM": nmea.MakeSentenceParser(ParsePGRMM),
-	"PGRMT": nmea.MakeSentenceParser(ParsePGRMT),
-	"PGRMV": nmea.MakeSentenceParser(ParsePGRMV),
-	"PGRMZ": nmea.MakeSentenceParser(ParsePGRMZ),
+	"PGRMB":  nmea.MakeSentenceParser(ParsePGRMB),
+	"PGRMC":  nmea.MakeSentenceParser(ParsePGRMC),
+	"PGRMC1": nmea.MakeSentenceParser(ParsePGRMC1),
+	"PGRME":  nmea.MakeSentenceParser(ParsePGRME),
+	"PGRMF":  nmea.MakeSentenceParser(ParsePGRMF),
+	"PGRMH":  nmea.MakeSentenceParser(ParsePGRMH),
+	"PGRMI":  nmea.MakeSentenceParser(ParsePGRMI),
+	"PGRMM":  nmea.MakeSentenceParser(ParsePGRMM),
+	"PGRMO":  nmea.MakeSentenceParser(ParsePGRMO),
+	"PGRMT":  nmea.MakeSentenceParser(ParsePGRMT),
+	"PGRMV":  nmea.MakeSentenceParser(ParsePGRMV),
+	"PGRMZ":  nmea.MakeSentenceParser(ParsePGRMZ),
 }
 
 func SentenceParserFunc(addr string) nmea.SentenceParser {
@@ -26,6 +26,45 @@ func TestSentenceParserFunc(t *testing.T) {
 					DGPSMode:      'N',
 				},
 			},
+			{
+				S: "$PGRMC,A,218.8,100,6378137.000,298.257223563,0.0,0.0,0.0,A,3,1,1,4,30*72",
+				Expected: &garmin.PGRMC{
+					Address:                    nmea.NewAddress("PGRMC"),
+					FixMode:                    nmea.NewOptional[byte]('A'),
+					Altitude:                   nmea.NewOptional(218.8),
+					EarthDatumIndex:            nmea.NewOptional(100),
+					UserDatumSemiMajorAxis:     nmea.NewOptional(6378137.0),
+					UserDatumInverseFlattening: nmea.NewOptional(298.257223563),
+					UserDatumDeltaX:            nmea.NewOptional(0.0),
+					UserDatumDeltaY:            nmea.NewOptional(0.0),
+					UserDatumDeltaZ:            nmea.NewOptional(0.0),
+					DifferentialMode:           nmea.NewOptional[byte]('A'),
+					BaudRate:                   nmea.NewOptional(3),
+					VelocityFilter:             nmea.NewOptional(1),
+					PPSMode:                    nmea.NewOptional(1),
+					PPSPulseLength:             nmea.NewOptional(4),
+					DeadReckoningValidTime:     nmea.NewOptional(30),
+				},
+			},
+			{
+				S: "$PGRMC,3,,,,,,,,,4,,,,*4C",
+				Expected: &garmin.PGRMC{
+					Address:  nmea.NewAddress("PGRMC"),
+					FixMode:  nmea.NewOptional[byte]('3'),
+					BaudRate: nmea.NewOptional(4),
+				},
+			},
+			{
+				S: "$PGRMC1,1,1,2,,,,2,1,1*56",
+				Expected: &garmin.PGRMC1{
+					Address:                  nmea.NewAddress("PGRMC1"),
+					NMEAOutputTime:           nmea.NewOptional(1),
+					BinaryPhaseOutput:        nmea.NewOptional(1),
+					AutomaticPositionAverage: nmea.NewOptional(2),
+					NMEA230ModeIndicator:     nmea.NewOptional(2),
+					Reserved:                 []string{"1", "1"},
+				},
+			},
 			{
 				S: "$PGRME,4.4,M,5.5,M,7.1,M*28",
 				Expected: &garmin.PGRME{
@@ -52,6 +91,25 @@ func TestSentenceParserFunc(t *testing.T) {
 					TDOP:             1,
 				},
 			},
+			{
+				S: "$PGRMI,4717.114,N,00833.916,E,160305,093802,A*11",
+				Expected: &garmin.PGRMI{
+					Address: nmea.NewAddress("PGRMI"),
+					Lat:     nmea.NewOptional(47.28523333333333),
+					Lon:     nmea.NewOptional(8.565266666666666),
+					Date: nmea.NewOptional(nmea.Date{
+						Year:  2005,
+						Month: time.March,
+						Day:   16,
+					}),
+					TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+						Hour:   9,
+						Minute: 38,
+						Second: 2,
+					}),
+					ReceiverCommand: nmea.NewOptional(garmin.ReceiverCommandAutoLocate),
+				},
+			},
 			{
 				S: "$PGRMM,WGS 84*06",
 				Expected: &garmin.PGRMM{
@@ -59,6 +117,21 @@ func TestSentenceParserFunc(t *testing.T) {
 					Datum:   "WGS 84",
 				},
 			},
+			{
+				S: "$PGRMO,GPGSV,0*22",
+				Expected: &garmin.PGRMO{
+					Address:        nmea.NewAddress("PGRMO"),
+					TargetSentence: "GPGSV",
+					Mode:           garmin.OutputModeDisable,
+				},
+			},
+			{
+				S: "$PGRMO,,2*75",
+				Expected: &garmin.PGRMO{
+					Address: nmea.NewAddress("PGRMO"),
+					Mode:    garmin.OutputModeDisableAll,
+				},
+			},
 			{
 				S: "$PGRMT,GPS 17-HVS Ver. 2.80,P,P,R,R,P,,37,R*0F",
 				Expected: &garmin.PGRMT{
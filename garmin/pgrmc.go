@@ -0,0 +1,78 @@
+package garmin
+
+import "github.com/twpayne/go-nmea"
+
+// BaudRates maps PGRMC baud rate indexes to baud rates.
+var BaudRates = map[int]int{
+	1: 1200,
+	2: 2400,
+	3: 4800,
+	4: 9600,
+	5: 19200,
+	6: 300,
+	7: 600,
+	8: 38400,
+}
+
+// A PGRMC is a sensor configuration sentence. It is sent to the receiver to
+// change its configuration, where empty fields leave the configuration
+// unchanged, and is echoed by the receiver with its full configuration.
+type PGRMC struct {
+	nmea.Address
+	FixMode                    nmea.Optional[byte]
+	Altitude                   nmea.Optional[float64]
+	EarthDatumIndex            nmea.Optional[int]
+	UserDatumSemiMajorAxis     nmea.Optional[float64]
+	UserDatumInverseFlattening nmea.Optional[float64]
+	UserDatumDeltaX            nmea.Optional[float64]
+	UserDatumDeltaY            nmea.Optional[float64]
+	UserDatumDeltaZ            nmea.Optional[float64]
+	DifferentialMode           nmea.Optional[byte]
+	BaudRate                   nmea.Optional[int]
+	VelocityFilter             nmea.Optional[int]
+	PPSMode                    nmea.Optional[int]
+	PPSPulseLength             nmea.Optional[int]
+	DeadReckoningValidTime     nmea.Optional[int]
+}
+
+func ParsePGRMC(addr string, tok *nmea.Tokenizer) (*PGRMC, error) {
+	var c PGRMC
+	c.Address = nmea.NewAddress(addr)
+	c.FixMode = tok.CommaOptionalOneByteOf("23A")
+	c.Altitude = tok.CommaOptionalFloat()
+	c.EarthDatumIndex = tok.CommaOptionalUnsignedInt()
+	c.UserDatumSemiMajorAxis = tok.CommaOptionalUnsignedFloat()
+	c.UserDatumInverseFlattening = tok.CommaOptionalUnsignedFloat()
+	c.UserDatumDeltaX = tok.CommaOptionalFloat()
+	c.UserDatumDeltaY = tok.CommaOptionalFloat()
+	c.UserDatumDeltaZ = tok.CommaOptionalFloat()
+	c.DifferentialMode = tok.CommaOptionalOneByteOf("AD")
+	c.BaudRate = tok.CommaOptionalUnsignedInt()
+	c.VelocityFilter = tok.CommaOptionalUnsignedInt()
+	if !tok.AtEndOfData() {
+		c.PPSMode = tok.CommaOptionalUnsignedInt()
+		c.PPSPulseLength = tok.CommaOptionalUnsignedInt()
+	}
+	if !tok.AtEndOfData() {
+		c.DeadReckoningValidTime = tok.CommaOptionalUnsignedInt()
+	}
+	tok.EndOfData()
+	return &c, tok.Err()
+}
+
+func (c *PGRMC) Encode(b *nmea.Builder) {
+	b.CommaOptionalByte(c.FixMode)
+	b.CommaOptionalFloat(c.Altitude, -1)
+	b.CommaOptionalInt(c.EarthDatumIndex)
+	b.CommaOptionalFloat(c.UserDatumSemiMajorAxis, -1)
+	b.CommaOptionalFloat(c.UserDatumInverseFlattening, -1)
+	b.CommaOptionalFloat(c.UserDatumDeltaX, -1)
+	b.CommaOptionalFloat(c.UserDatumDeltaY, -1)
+	b.CommaOptionalFloat(c.UserDatumDeltaZ, -1)
+	b.CommaOptionalByte(c.DifferentialMode)
+	b.CommaOptionalInt(c.BaudRate)
+	b.CommaOptionalInt(c.VelocityFilter)
+	b.CommaOptionalInt(c.PPSMode)
+	b.CommaOptionalInt(c.PPSPulseLength)
+	b.CommaOptionalInt(c.DeadReckoningValidTime)
+}
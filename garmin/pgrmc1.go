@@ -0,0 +1,47 @@
+package garmin
+
+import "github.com/twpayne/go-nmea"
+
+// A PGRMC1 is an additional sensor configuration sentence. Like PGRMC,
+// empty fields leave the configuration unchanged.
+type PGRMC1 struct {
+	nmea.Address
+	NMEAOutputTime           nmea.Optional[int]
+	BinaryPhaseOutput        nmea.Optional[int]
+	AutomaticPositionAverage nmea.Optional[int]
+	DGPSBeaconFrequency      nmea.Optional[float64]
+	DGPSBeaconBitRate        nmea.Optional[int]
+	DGPSBeaconAutoTune       nmea.Optional[int]
+	NMEA230ModeIndicator     nmea.Optional[int]
+	Reserved                 []string
+}
+
+func ParsePGRMC1(addr string, tok *nmea.Tokenizer) (*PGRMC1, error) {
+	var c1 PGRMC1
+	c1.Address = nmea.NewAddress(addr)
+	c1.NMEAOutputTime = tok.CommaOptionalUnsignedInt()
+	c1.BinaryPhaseOutput = tok.CommaOptionalUnsignedInt()
+	c1.AutomaticPositionAverage = tok.CommaOptionalUnsignedInt()
+	c1.DGPSBeaconFrequency = tok.CommaOptionalUnsignedFloat()
+	c1.DGPSBeaconBitRate = tok.CommaOptionalUnsignedInt()
+	c1.DGPSBeaconAutoTune = tok.CommaOptionalUnsignedInt()
+	c1.NMEA230ModeIndicator = tok.CommaOptionalUnsignedInt()
+	for !tok.AtEndOfData() {
+		c1.Reserved = append(c1.Reserved, tok.CommaString())
+	}
+	tok.EndOfData()
+	return &c1, tok.Err()
+}
+
+func (c1 *PGRMC1) Encode(b *nmea.Builder) {
+	b.CommaOptionalInt(c1.NMEAOutputTime)
+	b.CommaOptionalInt(c1.BinaryPhaseOutput)
+	b.CommaOptionalInt(c1.AutomaticPositionAverage)
+	b.CommaOptionalFloat(c1.DGPSBeaconFrequency, -1)
+	b.CommaOptionalInt(c1.DGPSBeaconBitRate)
+	b.CommaOptionalInt(c1.DGPSBeaconAutoTune)
+	b.CommaOptionalInt(c1.NMEA230ModeIndicator)
+	for _, reserved := range c1.Reserved {
+		b.CommaString(reserved)
+	}
+}
@@ -29,7 +29,7 @@ func ParsePGRMF(addr string, tok *nmea.Tokenizer) (*PGRMF, error) {
 	f.GPSSeconds = tok.CommaUnsignedInt()
 	date := tok.CommaDate()
 	timeOfDay := tok.CommaTimeOfDay()
-	f.Time = time.Date(date.Year, date.Month, date.Day, timeOfDay.Hour, timeOfDay.Minute, timeOfDay.Second, timeOfDay.Nanosecond, time.UTC)
+	f.Time = date.At(timeOfDay)
 	f.LeapSeconds = tok.CommaUnsignedInt()
 	f.Lat = tok.CommaLatDegMinCommaHemi()
 	f.Lon = tok.CommaLonDegMinCommaHemi()
@@ -0,0 +1,40 @@
+package garmin
+
+import "github.com/twpayne/go-nmea"
+
+// PGRMI receiver commands.
+const (
+	ReceiverCommandAutoLocate byte = 'A'
+	ReceiverCommandReset      byte = 'R'
+)
+
+// A PGRMI is a sensor initialization sentence, which gives the receiver an
+// initial position and time to reduce the time to first fix.
+type PGRMI struct {
+	nmea.Address
+	Lat             nmea.Optional[float64]
+	Lon             nmea.Optional[float64]
+	Date            nmea.Optional[nmea.Date]
+	TimeOfDay       nmea.Optional[nmea.TimeOfDay]
+	ReceiverCommand nmea.Optional[byte]
+}
+
+func ParsePGRMI(addr string, tok *nmea.Tokenizer) (*PGRMI, error) {
+	var i PGRMI
+	i.Address = nmea.NewAddress(addr)
+	i.Lat = tok.CommaOptionalLatDegMinCommaHemi()
+	i.Lon = tok.CommaOptionalLonDegMinCommaHemi()
+	i.Date = tok.CommaOptionalDate()
+	i.TimeOfDay = tok.CommaOptionalTimeOfDay()
+	i.ReceiverCommand = tok.CommaOptionalOneByteOf("AR")
+	tok.EndOfData()
+	return &i, tok.Err()
+}
+
+func (i *PGRMI) Encode(b *nmea.Builder) {
+	b.CommaOptionalLatDegMinCommaHemi(i.Lat, 3)
+	b.CommaOptionalLonDegMinCommaHemi(i.Lon, 3)
+	b.CommaOptionalDate(i.Date)
+	b.CommaOptionalTimeOfDay(i.TimeOfDay, 0)
+	b.CommaOptionalByte(i.ReceiverCommand)
+}
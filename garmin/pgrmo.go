@@ -0,0 +1,33 @@
+package garmin
+
+import "github.com/twpayne/go-nmea"
+
+// PGRMO target sentence modes.
+const (
+	OutputModeDisable        = 0
+	OutputModeEnable         = 1
+	OutputModeDisableAll     = 2
+	OutputModeEnableAll      = 3
+	OutputModeFactoryDefault = 4
+)
+
+// A PGRMO is an output sentence enable/disable sentence.
+type PGRMO struct {
+	nmea.Address
+	TargetSentence string
+	Mode           int
+}
+
+func ParsePGRMO(addr string, tok *nmea.Tokenizer) (*PGRMO, error) {
+	var o PGRMO
+	o.Address = nmea.NewAddress(addr)
+	o.TargetSentence = tok.CommaString()
+	o.Mode = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &o, tok.Err()
+}
+
+func (o *PGRMO) Encode(b *nmea.Builder) {
+	b.CommaString(o.TargetSentence)
+	b.CommaInt(o.Mode)
+}
@@ -0,0 +1,208 @@
+package garmin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// A ConfigMismatchError is returned when the configuration echoed by the
+// receiver does not match the configuration that was sent.
+type ConfigMismatchError struct {
+	Sent   *PGRMC
+	Echoed *PGRMC
+}
+
+func (e *ConfigMismatchError) Error() string {
+	return fmt.Sprintf("%s: echoed configuration does not match", e.Sent.Address)
+}
+
+// A Session sends commands to a Garmin receiver and reads its responses.
+//
+// A Session reads from the receiver in a separate goroutine, which exits
+// when the Session is closed and the next read returns, or when reading
+// returns an error. Sentences that are read while no command is waiting for
+// a response are discarded, so they are never mistaken for responses to
+// later commands.
+type Session struct {
+	w         io.Writer
+	results   chan sessionResult
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex
+	waiting   bool
+	wait      int
+}
+
+// An errReader records the first error returned by r.
+type errReader struct {
+	r   io.Reader
+	err error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
+type sessionResult struct {
+	wait     int
+	sentence nmea.Sentence
+	err      error
+}
+
+// NewSession returns a new Session that reads responses from r and writes
+// commands to w. Sentences other than responses are discarded.
+func NewSession(r io.Reader, w io.Writer) *Session {
+	s := &Session{
+		w:       w,
+		results: make(chan sessionResult),
+		done:    make(chan struct{}),
+	}
+	parser := nmea.NewParser(
+		nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineIgnore),
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineIgnore),
+		nmea.WithSentenceParserFunc(SentenceParserFunc),
+	)
+	go func() {
+		defer close(s.results)
+		er := &errReader{r: r}
+		for sentence, err := range nmea.Sentences(parser, er) {
+			if err != nil {
+				// Skip sentences that cannot be parsed. Read errors are
+				// handled below.
+				continue
+			}
+			s.mu.Lock()
+			waiting, wait := s.waiting, s.wait
+			s.mu.Unlock()
+			if !waiting {
+				continue
+			}
+			select {
+			case s.results <- sessionResult{wait: wait, sentence: sentence}:
+			case <-s.done:
+				return
+			}
+		}
+		err := er.err
+		if err == nil {
+			err = io.EOF
+		}
+		select {
+		case s.results <- sessionResult{err: err}:
+		case <-s.done:
+		}
+	}()
+	return s
+}
+
+// Close closes s. It does not close the underlying reader or writer. It is
+// safe to call Close more than once.
+func (s *Session) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+	return nil
+}
+
+// Configure sends pgrmc to the receiver and waits for the receiver to echo
+// its configuration. If any field set in pgrmc differs in the echoed
+// configuration then it returns the echoed configuration and a
+// *ConfigMismatchError.
+func (s *Session) Configure(ctx context.Context, pgrmc *PGRMC) (*PGRMC, error) {
+	echoed, err := s.sendAndWaitForPGRMC(ctx, pgrmc)
+	if err != nil {
+		return nil, err
+	}
+	if !pgrmc.matches(echoed) {
+		return echoed, &ConfigMismatchError{
+			Sent:   pgrmc,
+			Echoed: echoed,
+		}
+	}
+	return echoed, nil
+}
+
+// QueryConfig requests the receiver's configuration and waits for the
+// response.
+func (s *Session) QueryConfig(ctx context.Context) (*PGRMC, error) {
+	return s.sendAndWaitForPGRMC(ctx, &nmea.Unknown{Address: nmea.NewAddress("PGRMCE")})
+}
+
+// Send sends sentence to the receiver.
+func (s *Session) Send(sentence nmea.SentenceEncoder) error {
+	data, err := nmea.Marshal(sentence)
+	if err != nil {
+		return err
+	}
+	_, err = s.w.Write(data)
+	return err
+}
+
+// sendAndWaitForPGRMC sends sentence and waits for the first PGRMC sentence
+// read after it was called.
+func (s *Session) sendAndWaitForPGRMC(ctx context.Context, sentence nmea.SentenceEncoder) (*PGRMC, error) {
+	s.mu.Lock()
+	s.waiting = true
+	s.wait++
+	wait := s.wait
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.waiting = false
+		s.mu.Unlock()
+	}()
+
+	if err := s.Send(sentence); err != nil {
+		return nil, err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case result, ok := <-s.results:
+			switch {
+			case !ok:
+				return nil, io.EOF
+			case result.err != nil:
+				return nil, result.err
+			case result.wait != wait:
+				// Skip sentences read during an earlier wait.
+				continue
+			}
+			if pgrmc, ok := result.sentence.(*PGRMC); ok {
+				return pgrmc, nil
+			}
+		}
+	}
+}
+
+// matches returns whether every field that is set in c has the same value
+// in other.
+func (c *PGRMC) matches(other *PGRMC) bool {
+	return optionalMatches(c.FixMode, other.FixMode) &&
+		optionalMatches(c.Altitude, other.Altitude) &&
+		optionalMatches(c.EarthDatumIndex, other.EarthDatumIndex) &&
+		optionalMatches(c.UserDatumSemiMajorAxis, other.UserDatumSemiMajorAxis) &&
+		optionalMatches(c.UserDatumInverseFlattening, other.UserDatumInverseFlattening) &&
+		optionalMatches(c.UserDatumDeltaX, other.UserDatumDeltaX) &&
+		optionalMatches(c.UserDatumDeltaY, other.UserDatumDeltaY) &&
+		optionalMatches(c.UserDatumDeltaZ, other.UserDatumDeltaZ) &&
+		optionalMatches(c.DifferentialMode, other.DifferentialMode) &&
+		optionalMatches(c.BaudRate, other.BaudRate) &&
+		optionalMatches(c.VelocityFilter, other.VelocityFilter) &&
+		optionalMatches(c.PPSMode, other.PPSMode) &&
+		optionalMatches(c.PPSPulseLength, other.PPSPulseLength) &&
+		optionalMatches(c.DeadReckoningValidTime, other.DeadReckoningValidTime)
+}
+
+func optionalMatches[T comparable](want, got nmea.Optional[T]) bool {
+	return !want.Valid || got == want
+}
@@ -0,0 +1,135 @@
+package garmin_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/garmin"
+)
+
+// A fakeReceiver replies to each command with the current configuration,
+// updated with the fields set in the command, except for the baud rate,
+// which it never changes.
+type fakeReceiver struct {
+	config garmin.PGRMC
+}
+
+func (r *fakeReceiver) run(commands io.Reader, responses *io.PipeWriter) {
+	defer responses.Close()
+	scanner := bufio.NewScanner(commands)
+	parser := nmea.NewParser(
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineIgnore),
+		nmea.WithSentenceParserFunc(garmin.SentenceParserFunc),
+	)
+	for scanner.Scan() {
+		sentence, err := parser.ParseString(scanner.Text())
+		if err != nil {
+			continue
+		}
+		if pgrmc, ok := sentence.(*garmin.PGRMC); ok {
+			if pgrmc.FixMode.Valid {
+				r.config.FixMode = pgrmc.FixMode
+			}
+			if pgrmc.Altitude.Valid {
+				r.config.Altitude = pgrmc.Altitude
+			}
+		}
+		// Interleave other sentences with the response.
+		if _, err := responses.Write([]byte("$PGRMZ,5584,F,2*06\r\n")); err != nil {
+			return
+		}
+		data, err := nmea.Marshal(&r.config)
+		if err != nil {
+			responses.CloseWithError(err)
+			return
+		}
+		if _, err := responses.Write(data); err != nil {
+			return
+		}
+	}
+}
+
+func TestSession(t *testing.T) {
+	commandsR, commandsW := io.Pipe()
+	responsesR, responsesW := io.Pipe()
+	receiver := &fakeReceiver{
+		config: garmin.PGRMC{
+			Address:  nmea.NewAddress("PGRMC"),
+			FixMode:  nmea.NewOptional[byte]('A'),
+			BaudRate: nmea.NewOptional(3),
+		},
+	}
+	go receiver.run(commandsR, responsesW)
+
+	session := garmin.NewSession(responsesR, commandsW)
+	defer session.Close()
+	ctx := context.Background()
+
+	config, err := session.QueryConfig(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, nmea.NewOptional[byte]('A'), config.FixMode)
+
+	config, err = session.Configure(ctx, &garmin.PGRMC{
+		Address:  nmea.NewAddress("PGRMC"),
+		FixMode:  nmea.NewOptional[byte]('3'),
+		Altitude: nmea.NewOptional(218.8),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, nmea.NewOptional[byte]('3'), config.FixMode)
+	assert.Equal(t, nmea.NewOptional(218.8), config.Altitude)
+	assert.Equal(t, nmea.NewOptional(3), config.BaudRate)
+
+	sent := &garmin.PGRMC{
+		Address:  nmea.NewAddress("PGRMC"),
+		BaudRate: nmea.NewOptional(4),
+	}
+	config, err = session.Configure(ctx, sent)
+	var configMismatchError *garmin.ConfigMismatchError
+	assert.True(t, errors.As(err, &configMismatchError))
+	assert.Equal(t, sent, configMismatchError.Sent)
+	assert.Equal(t, nmea.NewOptional(3), config.BaudRate)
+
+	assert.NoError(t, commandsW.Close())
+	_, err = session.QueryConfig(ctx)
+	assert.Error(t, err)
+
+	assert.NoError(t, session.Close())
+	assert.NoError(t, session.Close())
+}
+
+func TestSessionDiscardsStaleResponses(t *testing.T) {
+	commandsR, commandsW := io.Pipe()
+	responsesR, responsesW := io.Pipe()
+	receiver := &fakeReceiver{
+		config: garmin.PGRMC{
+			Address: nmea.NewAddress("PGRMC"),
+			FixMode: nmea.NewOptional[byte]('A'),
+		},
+	}
+
+	session := garmin.NewSession(responsesR, commandsW)
+	defer session.Close()
+
+	// Send a configuration before any command. The second write returns
+	// only after the session has read the first.
+	stale, err := nmea.Marshal(&receiver.config)
+	assert.NoError(t, err)
+	_, err = responsesW.Write(stale)
+	assert.NoError(t, err)
+	_, err = responsesW.Write([]byte("$PGRMZ,5584,F,2*06\r\n"))
+	assert.NoError(t, err)
+
+	go receiver.run(commandsR, responsesW)
+	config, err := session.Configure(context.Background(), &garmin.PGRMC{
+		Address: nmea.NewAddress("PGRMC"),
+		FixMode: nmea.NewOptional[byte]('3'),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, nmea.NewOptional[byte]('3'), config.FixMode)
+}
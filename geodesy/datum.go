@@ -0,0 +1,120 @@
+package geodesy
+
+import "math"
+
+// A Helmert is a seven-parameter Helmert transformation in the position
+// vector convention. Translations are in meters, rotations in arcseconds,
+// and the scale in parts per million.
+type Helmert struct {
+	TX float64
+	TY float64
+	TZ float64
+	RX float64
+	RY float64
+	RZ float64
+	S  float64
+}
+
+// A Datum is a geodetic datum. Helmert transforms ECEF coordinates on the
+// datum to WGS84.
+type Datum struct {
+	Name      string
+	Ellipsoid Ellipsoid
+	Helmert   Helmert
+}
+
+// Ellipsoids.
+var (
+	Airy1830 = Ellipsoid{
+		A: 6377563.396,
+		F: 1 / 299.3249646,
+	}
+	Bessel1841 = Ellipsoid{
+		A: 6377397.155,
+		F: 1 / 299.1528128,
+	}
+	Clarke1866 = Ellipsoid{
+		A: 6378206.4,
+		F: 1 / 294.9786982,
+	}
+	GRS80 = Ellipsoid{
+		A: 6378137,
+		F: 1 / 298.257222101,
+	}
+	International1924 = Ellipsoid{
+		A: 6378388,
+		F: 1 / 297,
+	}
+	PZ90 = Ellipsoid{
+		A: 6378136,
+		F: 1 / 298.257839303,
+	}
+	WGS72 = Ellipsoid{
+		A: 6378135,
+		F: 1 / 298.26,
+	}
+)
+
+// Datums maps the datum codes used in DTM sentences to datums. The
+// parameters for the IHO datum codes are the mean values from NIMA TR8350.2.
+var Datums = map[string]Datum{
+	"EUR": {
+		Name:      "European 1950",
+		Ellipsoid: International1924,
+		Helmert:   Helmert{TX: -87, TY: -98, TZ: -121},
+	},
+	"NAR": {
+		Name:      "North American 1983",
+		Ellipsoid: GRS80,
+	},
+	"NAS": {
+		Name:      "North American 1927",
+		Ellipsoid: Clarke1866,
+		Helmert:   Helmert{TX: -8, TY: 160, TZ: 176},
+	},
+	"OGB": {
+		Name:      "Ordnance Survey of Great Britain 1936",
+		Ellipsoid: Airy1830,
+		Helmert:   Helmert{TX: 375, TY: -111, TZ: 431},
+	},
+	"P90": {
+		Name:      "PZ-90.02",
+		Ellipsoid: PZ90,
+		Helmert:   Helmert{TX: -0.36, TY: 0.08, TZ: 0.18},
+	},
+	"TOY": {
+		Name:      "Tokyo",
+		Ellipsoid: Bessel1841,
+		Helmert:   Helmert{TX: -148, TY: 507, TZ: 685},
+	},
+	"W72": {
+		Name:      "WGS72",
+		Ellipsoid: WGS72,
+		Helmert:   Helmert{TZ: 4.5, RZ: 0.554, S: 0.2263},
+	},
+	"W84": {
+		Name:      "WGS84",
+		Ellipsoid: WGS84,
+	},
+}
+
+// Apply returns the result of applying h to e.
+func (h Helmert) Apply(e ECEF) ECEF {
+	const arcsecond = math.Pi / (180 * 3600)
+	rx, ry, rz := h.RX*arcsecond, h.RY*arcsecond, h.RZ*arcsecond
+	s := 1 + h.S*1e-6
+	return ECEF{
+		X: h.TX + s*(e.X-rz*e.Y+ry*e.Z),
+		Y: h.TY + s*(rz*e.X+e.Y-rx*e.Z),
+		Z: h.TZ + s*(-ry*e.X+rx*e.Y+e.Z),
+	}
+}
+
+// ToWGS84 returns the WGS84 position and height of p at height above d's
+// ellipsoid.
+func (d Datum) ToWGS84(p LatLon, height float64) (LatLon, float64) {
+	if d.Ellipsoid == WGS84 && d.Helmert == (Helmert{}) {
+		return p, height
+	}
+	return WGS84.FromECEF(d.Helmert.Apply(d.Ellipsoid.ToECEF(p, height)))
+}
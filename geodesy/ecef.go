@@ -0,0 +1,98 @@
+package geodesy
+
+import "math"
+
+// An ECEF is a position in Earth-centered, Earth-fixed coordinates.
+type ECEF struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+// An ENU is a position in local east, north, up coordinates relative to an
+// origin.
+type ENU struct {
+	E float64
+	N float64
+	U float64
+}
+
+// ECEF returns the ECEF coordinates of p at height above the WGS84
+// ellipsoid.
+func (p LatLon) ECEF(height float64) ECEF {
+	return WGS84.ToECEF(p, height)
+}
+
+// ENU returns the ENU coordinates of e relative to origin at originHeight
+// above the WGS84 ellipsoid.
+func (e ECEF) ENU(origin LatLon, originHeight float64) ENU {
+	o := origin.ECEF(originHeight)
+	dx, dy, dz := e.X-o.X, e.Y-o.Y, e.Z-o.Z
+	sinPhi, cosPhi := math.Sincos(radians(origin.Lat))
+	sinLambda, cosLambda := math.Sincos(radians(origin.Lon))
+	return ENU{
+		E: -sinLambda*dx + cosLambda*dy,
+		N: -sinPhi*cosLambda*dx - sinPhi*sinLambda*dy + cosPhi*dz,
+		U: cosPhi*cosLambda*dx + cosPhi*sinLambda*dy + sinPhi*dz,
+	}
+}
+
+// LatLonHeight returns the position and height above the WGS84 ellipsoid of
+// e.
+func (e ECEF) LatLonHeight() (LatLon, float64) {
+	return WGS84.FromECEF(e)
+}
+
+// ECEF returns the ECEF coordinates of e relative to origin at originHeight
+// above the WGS84 ellipsoid.
+func (e ENU) ECEF(origin LatLon, originHeight float64) ECEF {
+	o := origin.ECEF(originHeight)
+	sinPhi, cosPhi := math.Sincos(radians(origin.Lat))
+	sinLambda, cosLambda := math.Sincos(radians(origin.Lon))
+	return ECEF{
+		X: o.X - sinLambda*e.E - sinPhi*cosLambda*e.N + cosPhi*cosLambda*e.U,
+		Y: o.Y + cosLambda*e.E - sinPhi*sinLambda*e.N + cosPhi*sinLambda*e.U,
+		Z: o.Z + cosPhi*e.N + sinPhi*e.U,
+	}
+}
+
+// FromECEF returns the position and height above el of e, using Bowring's
+// method. Points on the polar axis have longitude zero.
+func (el Ellipsoid) FromECEF(e ECEF) (LatLon, float64) {
+	a, b, e2 := el.A, el.B(), el.E2()
+	p := math.Hypot(e.X, e.Y)
+	if p == 0 {
+		lat := 90.0
+		if e.Z < 0 {
+			lat = -90
+		}
+		return LatLon{Lat: lat}, math.Abs(e.Z) - b
+	}
+	epsilon2 := e2 / (1 - e2)
+	r := math.Hypot(p, e.Z)
+	tanBeta := b * e.Z / (a * p) * (1 + epsilon2*b/r)
+	cosBeta := 1 / math.Sqrt(1+tanBeta*tanBeta)
+	sinBeta := tanBeta * cosBeta
+	phi := math.Atan2(e.Z+epsilon2*b*sinBeta*sinBeta*sinBeta, p-e2*a*cosBeta*cosBeta*cosBeta)
+	lambda := math.Atan2(e.Y, e.X)
+	sinPhi, cosPhi := math.Sincos(phi)
+	nu := a / math.Sqrt(1-e2*sinPhi*sinPhi)
+	height := p*cosPhi + e.Z*sinPhi - a*a/nu
+	return LatLon{
+		Lat: degrees(phi),
+		Lon: degrees(lambda),
+	}, height
+}
+
+// ToECEF returns the ECEF coordinates of p at height above el.
+func (el Ellipsoid) ToECEF(p LatLon, height float64) ECEF {
+	e2 := el.E2()
+	sinPhi, cosPhi := math.Sincos(radians(p.Lat))
+	sinLambda, cosLambda := math.Sincos(radians(p.Lon))
+	nu := el.A / math.Sqrt(1-e2*sinPhi*sinPhi)
+	return ECEF{
+		X: (nu + height) * cosPhi * cosLambda,
+		Y: (nu + height) * cosPhi * sinLambda,
+		Z: (nu*(1-e2) + height) * sinPhi,
+	}
+}
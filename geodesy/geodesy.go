@@ -0,0 +1,100 @@
+// Package geodesy computes distances, bearings, and coordinate conversions
+// for the positions in NMEA sentences, and transforms positions reported in
+// local datums to WGS84.
+//
+// Latitudes, longitudes, and bearings are in degrees and distances and
+// heights are in meters. Bearings are clockwise from true north in the range
+// [0, 360).
+package geodesy
+
+import (
+	"math"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/standard"
+	"github.com/twpayne/go-nmea/ublox"
+)
+
+// EarthRadius is the mean radius of the Earth, used for spherical
+// calculations.
+const EarthRadius = 6371008.8
+
+// An Ellipsoid is a reference ellipsoid with semi-major axis A and
+// flattening F.
+type Ellipsoid struct {
+	A float64
+	F float64
+}
+
+// WGS84 is the ellipsoid used by GPS.
+var WGS84 = Ellipsoid{
+	A: 6378137,
+	F: 1 / 298.257223563,
+}
+
+// A LatLon is a position.
+type LatLon struct {
+	Lat float64
+	Lon float64
+}
+
+// B returns e's semi-minor axis.
+func (e Ellipsoid) B() float64 {
+	return e.A * (1 - e.F)
+}
+
+// E2 returns the square of e's first eccentricity.
+func (e Ellipsoid) E2() float64 {
+	return e.F * (2 - e.F)
+}
+
+// FromSentence returns the position in sentence, which must be a
+// *standard.GGA, *standard.GLL, *standard.GNS, *standard.RMC, or
+// *ublox.Position. It returns false if sentence is of another type or does
+// not contain a position. It does not check whether the sentence reports a
+// valid fix.
+func FromSentence(sentence nmea.Sentence) (LatLon, bool) {
+	switch s := sentence.(type) {
+	case *standard.GGA:
+		return fromOptionals(s.Lat, s.Lon)
+	case *standard.GLL:
+		return fromOptionals(s.Lat, s.Lon)
+	case *standard.GNS:
+		return fromOptionals(s.Lat, s.Lon)
+	case *standard.RMC:
+		return fromOptionals(s.Lat, s.Lon)
+	case *ublox.Position:
+		return LatLon{Lat: s.Lat, Lon: s.Lon}, true
+	default:
+		return LatLon{}, false
+	}
+}
+
+func fromOptionals(lat, lon nmea.Optional[float64]) (LatLon, bool) {
+	if !lat.Valid || !lon.Valid {
+		return LatLon{}, false
+	}
+	return LatLon{Lat: lat.Value, Lon: lon.Value}, true
+}
+
+func degrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// normalizeBearing returns bearing in the range [0, 360).
+func normalizeBearing(bearing float64) float64 {
+	bearing = math.Mod(bearing, 360)
+	if bearing < 0 {
+		bearing += 360
+	}
+	return bearing
+}
+
+// normalizeLon returns lon in the range [-180, 180).
+func normalizeLon(lon float64) float64 {
+	return normalizeBearing(lon+180) - 180
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,292 @@
+package geodesy_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/geodesy"
+	"github.com/twpayne/go-nmea/standard"
+	"github.com/twpayne/go-nmea/ublox"
+)
+
+func assertInDelta(t *testing.T, expected, actual, delta float64) {
+	t.Helper()
+	if math.Abs(expected-actual) > delta {
+		t.Errorf("expected %v, got %v (delta %v)", expected, actual, delta)
+	}
+}
+
+func dms(deg, min, sec float64) float64 {
+	if deg < 0 {
+		return deg - min/60 - sec/3600
+	}
+	return deg + min/60 + sec/3600
+}
+
+func TestFromSentence(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		sentence   nmea.Sentence
+		expected   geodesy.LatLon
+		expectedOK bool
+	}{
+		{
+			name: "gga",
+			sentence: &standard.GGA{
+				Lat: nmea.NewOptional(47.3),
+				Lon: nmea.NewOptional(8.5),
+			},
+			expected:   geodesy.LatLon{Lat: 47.3, Lon: 8.5},
+			expectedOK: true,
+		},
+		{
+			name:     "gga_no_position",
+			sentence: &standard.GGA{},
+		},
+		{
+			name: "rmc",
+			sentence: &standard.RMC{
+				Lat: nmea.NewOptional(-33.857),
+				Lon: nmea.NewOptional(151.215),
+			},
+			expected:   geodesy.LatLon{Lat: -33.857, Lon: 151.215},
+			expectedOK: true,
+		},
+		{
+			name: "ublox_position",
+			sentence: &ublox.Position{
+				Lat: 47.28522016666667,
+				Lon: 8.565253116666666,
+			},
+			expected:   geodesy.LatLon{Lat: 47.28522016666667, Lon: 8.565253116666666},
+			expectedOK: true,
+		},
+		{
+			name:     "other",
+			sentence: &standard.TXT{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, ok := geodesy.FromSentence(tc.sentence)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSpherical(t *testing.T) {
+	p := geodesy.LatLon{Lat: dms(50, 3, 59), Lon: -dms(5, 42, 53)}
+	q := geodesy.LatLon{Lat: dms(58, 38, 38), Lon: -dms(3, 4, 12)}
+	assertInDelta(t, 968855, p.HaversineDistance(q), 1)
+	assertInDelta(t, dms(9, 7, 11), p.InitialBearing(q), 1e-3)
+	assertInDelta(t, dms(11, 16, 31), p.FinalBearing(q), 1e-3)
+
+	destination := geodesy.LatLon{Lat: dms(53, 19, 14), Lon: -dms(1, 43, 47)}.Destination(dms(96, 1, 18), 124800)
+	assertInDelta(t, dms(53, 11, 18), destination.Lat, 1e-3)
+	assertInDelta(t, dms(0, 8, 0), destination.Lon, 1e-3)
+
+	start := geodesy.LatLon{Lat: 53.3206, Lon: -1.7297}
+	end := geodesy.LatLon{Lat: 53.1887, Lon: 0.1334}
+	point := geodesy.LatLon{Lat: 53.2611, Lon: -0.7972}
+	assertInDelta(t, -307.5, point.CrossTrackDistance(start, end), 0.1)
+	assertInDelta(t, 62331.6, point.AlongTrackDistance(start, end), 0.1)
+}
+
+func TestVincenty(t *testing.T) {
+	// Flinders Peak to Buninyong, from Vincenty's paper.
+	p := geodesy.LatLon{Lat: dms(-37, 57, 3.72030), Lon: dms(144, 25, 29.52440)}
+	q := geodesy.LatLon{Lat: dms(-37, 39, 10.15610), Lon: dms(143, 55, 35.38390)}
+	distance, initialBearing, finalBearing, err := p.VincentyInverse(q)
+	assert.NoError(t, err)
+	assertInDelta(t, 54972.271, distance, 1e-3)
+	assertInDelta(t, dms(306, 52, 5.37), initialBearing, 1e-5)
+	assertInDelta(t, dms(307, 10, 25.07), finalBearing, 1e-5)
+
+	destination, finalBearing := p.VincentyDestination(initialBearing, distance)
+	assertInDelta(t, q.Lat, destination.Lat, 1e-9)
+	assertInDelta(t, q.Lon, destination.Lon, 1e-9)
+	assertInDelta(t, dms(307, 10, 25.07), finalBearing, 1e-5)
+
+	distance, _, _, err = p.VincentyInverse(p)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, distance)
+
+	_, _, _, err = geodesy.LatLon{Lat: 0, Lon: 0}.VincentyInverse(geodesy.LatLon{Lat: 0.5, Lon: 179.7})
+	assert.IsError(t, err, geodesy.ErrVincentyNotConverged)
+}
+
+func TestECEF(t *testing.T) {
+	ecef := geodesy.LatLon{Lat: 0, Lon: 0}.ECEF(0)
+	assertInDelta(t, geodesy.WGS84.A, ecef.X, 1e-9)
+	assertInDelta(t, 0, ecef.Y, 1e-9)
+	assertInDelta(t, 0, ecef.Z, 1e-9)
+
+	p := geodesy.LatLon{Lat: 47.3, Lon: 8.5}
+	actual, height := p.ECEF(500).LatLonHeight()
+	assertInDelta(t, p.Lat, actual.Lat, 1e-9)
+	assertInDelta(t, p.Lon, actual.Lon, 1e-9)
+	assertInDelta(t, 500, height, 1e-6)
+
+	for _, lat := range []float64{90, -90} {
+		actual, height := geodesy.ECEF{Z: lat / 90 * geodesy.WGS84.B()}.LatLonHeight()
+		assert.Equal(t, geodesy.LatLon{Lat: lat}, actual)
+		assertInDelta(t, 0, height, 1e-9)
+	}
+	actual, height = geodesy.ECEF{Z: 6356752.314245 + 100}.LatLonHeight()
+	assert.Equal(t, geodesy.LatLon{Lat: 90}, actual)
+	assertInDelta(t, 100, height, 1e-6)
+
+	equator := geodesy.LatLon{Lat: 0, Lon: -45}
+	actual, height = equator.ECEF(10).LatLonHeight()
+	assertInDelta(t, equator.Lat, actual.Lat, 1e-9)
+	assertInDelta(t, equator.Lon, actual.Lon, 1e-9)
+	assertInDelta(t, 10, height, 1e-6)
+
+	north := geodesy.LatLon{Lat: 47.3, Lon: 8.5}.Destination(0, 100).ECEF(510)
+	enu := north.ENU(p, 500)
+	assertInDelta(t, 0, enu.E, 1e-6)
+	assertInDelta(t, 100, enu.N, 0.5)
+	assertInDelta(t, 10, enu.U, 0.01)
+	assert.Equal(t, north, enu.ECEF(p, 500))
+}
+
+func TestUTM(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		latLon       geodesy.LatLon
+		expected     string
+		expectedMGRS string
+	}{
+		{
+			name:         "eiffel_tower",
+			latLon:       geodesy.LatLon{Lat: 48.8582, Lon: 2.2945},
+			expected:     "31U 448252 5411933",
+			expectedMGRS: "31UDQ4825111932",
+		},
+		{
+			name:         "southern_hemisphere",
+			latLon:       geodesy.LatLon{Lat: -33.857, Lon: 151.215},
+			expected:     "56H 334873 6252266",
+			expectedMGRS: "56HLH3487352266",
+		},
+		{
+			name:         "norway",
+			latLon:       geodesy.LatLon{Lat: 60, Lon: 5},
+			expected:     "32V 276980 6658157",
+			expectedMGRS: "32VKM7697958157",
+		},
+		{
+			name:         "svalbard",
+			latLon:       geodesy.LatLon{Lat: 78, Lon: 10},
+			expected:     "33X 384085 8663320",
+			expectedMGRS: "33XUG8408563320",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			utm, err := tc.latLon.UTM()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, utm.String())
+			mgrs, err := tc.latLon.MGRS(5)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedMGRS, mgrs)
+		})
+	}
+
+	mgrs, err := geodesy.LatLon{Lat: 48.8582, Lon: 2.2945}.MGRS(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "31UDQ4811", mgrs)
+
+	_, err = geodesy.LatLon{Lat: 85, Lon: 0}.UTM()
+	assert.IsError(t, err, geodesy.ErrOutsideUTM)
+	_, err = geodesy.LatLon{Lat: 0, Lon: 0}.MGRS(6)
+	assert.IsError(t, err, geodesy.ErrInvalidPrecision)
+}
+
+func TestTransformer(t *testing.T) {
+	transformer := geodesy.NewTransformer()
+	gga := &standard.GGA{
+		Address:                          nmea.NewAddress("GPGGA"),
+		Lat:                              nmea.NewOptional(47.3),
+		Lon:                              nmea.NewOptional(8.5),
+		Alt:                              nmea.NewOptional(500.0),
+		HeightOfGeoidAboveWGS84Ellipsoid: nmea.NewOptional(48.0),
+	}
+
+	fix, err := transformer.Fix(&standard.TXT{})
+	assert.NoError(t, err)
+	assert.Zero(t, fix)
+
+	fix, err = transformer.Fix(gga)
+	assert.NoError(t, err)
+	assert.Equal(t, &geodesy.Fix{
+		Sentence: gga,
+		LatLon:   geodesy.LatLon{Lat: 47.3, Lon: 8.5},
+		Alt:      nmea.NewOptional(500.0),
+		Datum:    "W84",
+	}, fix)
+
+	fix, err = transformer.Fix(&standard.DTM{
+		Datum:    "999",
+		Lat:      0.08 / 60,
+		Lon:      0.07 / 60,
+		Alt:      -47.7,
+		RefDatum: "W84",
+	})
+	assert.NoError(t, err)
+	assert.Zero(t, fix)
+	fix, err = transformer.Fix(gga)
+	assert.NoError(t, err)
+	assert.Equal(t, "999", fix.Datum)
+	assertInDelta(t, 47.3-0.08/60, fix.LatLon.Lat, 1e-12)
+	assertInDelta(t, 8.5-0.07/60, fix.LatLon.Lon, 1e-12)
+	assertInDelta(t, 547.7, fix.Alt.Value, 1e-9)
+
+	_, err = transformer.Fix(&standard.DTM{
+		Datum:    "W72",
+		RefDatum: "W84",
+	})
+	assert.NoError(t, err)
+	fix, err = transformer.Fix(gga)
+	assert.NoError(t, err)
+	assert.Equal(t, "W72", fix.Datum)
+	assertInDelta(t, 8.5+0.554/3600, fix.LatLon.Lon, 1e-8)
+	assertInDelta(t, 47.3, fix.LatLon.Lat, 1e-4)
+	assert.True(t, fix.Alt.Valid)
+
+	_, err = transformer.Fix(&standard.DTM{
+		Datum:    "XXX",
+		RefDatum: "W84",
+	})
+	assert.NoError(t, err)
+	_, err = transformer.Fix(gga)
+	var unknownDatumError *geodesy.UnknownDatumError
+	assert.True(t, errors.As(err, &unknownDatumError))
+	assert.Equal(t, "XXX", unknownDatumError.Datum)
+
+	transformer = geodesy.NewTransformer(geodesy.WithDatum("XXX", geodesy.Datums["W72"]))
+	_, err = transformer.Fix(&standard.DTM{
+		Datum:    "XXX",
+		RefDatum: "W84",
+	})
+	assert.NoError(t, err)
+	fix, err = transformer.Fix(gga)
+	assert.NoError(t, err)
+	assertInDelta(t, 8.5+0.554/3600, fix.LatLon.Lon, 1e-8)
+}
+
+func TestDatumToWGS84(t *testing.T) {
+	// The transformation of a point near Greenwich from OSGB36 differs from
+	// WGS84 by about 100 m.
+	p := geodesy.LatLon{Lat: 51.4778, Lon: -0.0014}
+	actual, _ := geodesy.Datums["OGB"].ToWGS84(p, 0)
+	distance := p.HaversineDistance(actual)
+	assert.True(t, 80 < distance && distance < 150)
+
+	actual, height := geodesy.Datums["W84"].ToWGS84(p, 10)
+	assert.Equal(t, p, actual)
+	assert.Equal(t, 10.0, height)
+}
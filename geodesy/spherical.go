@@ -0,0 +1,67 @@
+package geodesy
+
+import "math"
+
+// AlongTrackDistance returns the distance from start to the point on the
+// great circle through start and end that is closest to p.
+func (p LatLon) AlongTrackDistance(start, end LatLon) float64 {
+	delta13 := start.angularDistance(p)
+	deltaXt := math.Asin(math.Sin(delta13) * math.Sin(radians(start.InitialBearing(p))-radians(start.InitialBearing(end))))
+	deltaAt := math.Acos(math.Cos(delta13) / math.Cos(deltaXt))
+	return math.Copysign(deltaAt, math.Cos(radians(start.InitialBearing(p))-radians(start.InitialBearing(end)))) * EarthRadius
+}
+
+// CrossTrackDistance returns the distance from p to the great circle through
+// start and end. It is positive if p is to the right of the path from start
+// to end and negative if it is to the left.
+func (p LatLon) CrossTrackDistance(start, end LatLon) float64 {
+	delta13 := start.angularDistance(p)
+	theta13 := radians(start.InitialBearing(p))
+	theta12 := radians(start.InitialBearing(end))
+	return math.Asin(math.Sin(delta13)*math.Sin(theta13-theta12)) * EarthRadius
+}
+
+// Destination returns the point reached by traveling distance from p along
+// the great circle with initial bearing.
+func (p LatLon) Destination(bearing, distance float64) LatLon {
+	phi1, lambda1 := radians(p.Lat), radians(p.Lon)
+	theta := radians(bearing)
+	delta := distance / EarthRadius
+	sinPhi2 := math.Sin(phi1)*math.Cos(delta) + math.Cos(phi1)*math.Sin(delta)*math.Cos(theta)
+	phi2 := math.Asin(sinPhi2)
+	lambda2 := lambda1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(phi1), math.Cos(delta)-math.Sin(phi1)*sinPhi2)
+	return LatLon{
+		Lat: degrees(phi2),
+		Lon: normalizeLon(degrees(lambda2)),
+	}
+}
+
+// FinalBearing returns the bearing on arrival at q along the great circle
+// from p.
+func (p LatLon) FinalBearing(q LatLon) float64 {
+	return normalizeBearing(q.InitialBearing(p) + 180)
+}
+
+// HaversineDistance returns the great circle distance from p to q.
+func (p LatLon) HaversineDistance(q LatLon) float64 {
+	return p.angularDistance(q) * EarthRadius
+}
+
+// InitialBearing returns the initial bearing of the great circle from p to
+// q.
+func (p LatLon) InitialBearing(q LatLon) float64 {
+	phi1, phi2 := radians(p.Lat), radians(q.Lat)
+	deltaLambda := radians(q.Lon - p.Lon)
+	y := math.Sin(deltaLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(deltaLambda)
+	return normalizeBearing(degrees(math.Atan2(y, x)))
+}
+
+// angularDistance returns the angular distance from p to q in radians.
+func (p LatLon) angularDistance(q LatLon) float64 {
+	phi1, phi2 := radians(p.Lat), radians(q.Lat)
+	deltaPhi := phi2 - phi1
+	deltaLambda := radians(q.Lon - p.Lon)
+	a := math.Sin(deltaPhi/2)*math.Sin(deltaPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(deltaLambda/2)*math.Sin(deltaLambda/2)
+	return 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
@@ -0,0 +1,135 @@
+package geodesy
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/standard"
+	"github.com/twpayne/go-nmea/ublox"
+)
+
+// An UnknownDatumError is returned when positions are reported in a datum
+// that cannot be transformed to WGS84.
+type UnknownDatumError struct {
+	Datum string
+}
+
+func (e *UnknownDatumError) Error() string {
+	return fmt.Sprintf("%s: unknown datum", e.Datum)
+}
+
+// A Fix is a position transformed to WGS84. Datum is the code of the datum
+// in which the position was reported. Alt is the altitude reported in the
+// sentence, corrected by the change in ellipsoidal height.
+type Fix struct {
+	Sentence nmea.Sentence
+	LatLon   LatLon
+	Alt      nmea.Optional[float64]
+	Datum    string
+}
+
+// A Transformer transforms positions reported in a local datum to WGS84
+// using the most recent DTM sentence.
+//
+// If the DTM sentence has non-zero offsets, or its local datum is the same
+// as its reference datum, then the offsets, which are from the reference
+// datum to the local datum, are subtracted. Otherwise positions are
+// transformed from the local datum using Datums. In both cases positions in
+// a reference datum other than WGS84 are then transformed using Datums.
+type Transformer struct {
+	datums map[string]Datum
+	dtm    *standard.DTM
+}
+
+type TransformerOption func(*Transformer)
+
+// WithDatum adds or replaces the datum with code.
+func WithDatum(code string, datum Datum) TransformerOption {
+	return func(t *Transformer) {
+		t.datums[code] = datum
+	}
+}
+
+func NewTransformer(options ...TransformerOption) *Transformer {
+	t := &Transformer{
+		datums: maps.Clone(Datums),
+	}
+	for _, option := range options {
+		option(t)
+	}
+	return t
+}
+
+// Fix returns the WGS84 fix in sentence. It returns nil if sentence does not
+// contain a position. Positions are assumed to be in WGS84 until a DTM
+// sentence is seen.
+func (t *Transformer) Fix(sentence nmea.Sentence) (*Fix, error) {
+	if dtm, ok := sentence.(*standard.DTM); ok {
+		t.dtm = dtm
+		return nil, nil
+	}
+	latLon, ok := FromSentence(sentence)
+	if !ok {
+		return nil, nil
+	}
+	fix := &Fix{
+		Sentence: sentence,
+		LatLon:   latLon,
+		Alt:      altOf(sentence),
+		Datum:    "W84",
+	}
+	if t.dtm == nil {
+		return fix, nil
+	}
+	fix.Datum = t.dtm.Datum
+
+	code := t.dtm.Datum
+	if t.dtm.Lat != 0 || t.dtm.Lon != 0 || t.dtm.Alt != 0 || t.dtm.Datum == t.dtm.RefDatum {
+		fix.LatLon.Lat -= t.dtm.Lat
+		fix.LatLon.Lon = normalizeLon(fix.LatLon.Lon - t.dtm.Lon)
+		if fix.Alt.Valid {
+			fix.Alt.Value -= t.dtm.Alt
+		}
+		code = t.dtm.RefDatum
+	}
+	datum, ok := t.datums[code]
+	if !ok {
+		return nil, &UnknownDatumError{
+			Datum: code,
+		}
+	}
+	height := ellipsoidalHeight(sentence, fix.Alt)
+	var wgs84Height float64
+	fix.LatLon, wgs84Height = datum.ToWGS84(fix.LatLon, height)
+	if fix.Alt.Valid {
+		fix.Alt.Value += wgs84Height - height
+	}
+	return fix, nil
+}
+
+func altOf(sentence nmea.Sentence) nmea.Optional[float64] {
+	switch s := sentence.(type) {
+	case *standard.GGA:
+		return s.Alt
+	case *standard.GNS:
+		return s.Alt
+	case *ublox.Position:
+		return nmea.NewOptional(s.AltRef)
+	default:
+		return nmea.Optional[float64]{}
+	}
+}
+
+// ellipsoidalHeight returns an approximate height above the ellipsoid for
+// the transformation. Its effect on the transformed position is small, so
+// zero is used if it is unknown.
+func ellipsoidalHeight(sentence nmea.Sentence, alt nmea.Optional[float64]) float64 {
+	if !alt.Valid {
+		return 0
+	}
+	if gga, ok := sentence.(*standard.GGA); ok && gga.HeightOfGeoidAboveWGS84Ellipsoid.Valid {
+		return alt.Value + gga.HeightOfGeoidAboveWGS84Ellipsoid.Value
+	}
+	return alt.Value
+}
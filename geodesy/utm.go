@@ -0,0 +1,138 @@
+package geodesy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	utmFalseEasting      = 500000
+	utmFalseNorthing     = 10000000
+	utmScaleFactor       = 0.9996
+	utmLatitudeBands     = "CDEFGHJKLMNPQRSTUVWXX"
+	mgrsSquareSize       = 100000
+	mgrsDefaultPrecision = 5
+)
+
+var (
+	mgrsColumnLetters = [3]string{"ABCDEFGH", "JKLMNPQR", "STUVWXYZ"}
+	mgrsRowLetters    = [2]string{"ABCDEFGHJKLMNPQRSTUV", "FGHJKLMNPQRSTUVABCDE"}
+)
+
+var (
+	ErrInvalidPrecision = errors.New("invalid precision")
+	ErrOutsideUTM       = errors.New("outside UTM limits")
+)
+
+// A UTM is a position in Universal Transverse Mercator coordinates on the
+// WGS84 ellipsoid. Northing includes the false northing in the southern
+// hemisphere.
+type UTM struct {
+	Zone     int
+	Band     byte
+	Easting  float64
+	Northing float64
+}
+
+// MGRS returns p's Military Grid Reference System reference, for example
+// 31UDQ4825111932, with precision digits each of easting and northing, from
+// 1, for 10 km, to 5, for 1 m.
+func (p LatLon) MGRS(precision int) (string, error) {
+	if precision < 1 || mgrsDefaultPrecision < precision {
+		return "", ErrInvalidPrecision
+	}
+	u, err := p.UTM()
+	if err != nil {
+		return "", err
+	}
+	column := int(math.Floor(u.Easting / mgrsSquareSize))
+	row := int(math.Floor(u.Northing/mgrsSquareSize)) % 20
+	columnLetter := mgrsColumnLetters[(u.Zone-1)%3][column-1]
+	rowLetter := mgrsRowLetters[(u.Zone-1)%2][row]
+	divisor := math.Pow10(mgrsDefaultPrecision - precision)
+	easting := int(math.Floor(math.Mod(u.Easting, mgrsSquareSize) / divisor))
+	northing := int(math.Floor(math.Mod(u.Northing, mgrsSquareSize) / divisor))
+	return fmt.Sprintf("%02d%c%c%c%0*d%0*d", u.Zone, u.Band, columnLetter, rowLetter, precision, easting, precision, northing), nil
+}
+
+// UTM returns p's UTM coordinates, including the Norway and Svalbard zone
+// exceptions, computed with Kruger's series to order n^6.
+func (p LatLon) UTM() (UTM, error) {
+	if p.Lat < -80 || 84 < p.Lat {
+		return UTM{}, ErrOutsideUTM
+	}
+	lon := normalizeLon(p.Lon)
+	zone := int(math.Floor((lon+180)/6)) + 1
+	band := utmLatitudeBands[int(math.Floor(p.Lat/8+10))]
+	switch {
+	case zone == 31 && band == 'V' && lon >= 3:
+		zone = 32
+	case band == 'X' && zone == 32:
+		zone = 31
+		if lon >= 9 {
+			zone = 33
+		}
+	case band == 'X' && zone == 34:
+		zone = 33
+		if lon >= 21 {
+			zone = 35
+		}
+	case band == 'X' && zone == 36:
+		zone = 35
+		if lon >= 33 {
+			zone = 37
+		}
+	}
+	centralMeridian := float64(6*zone - 183)
+
+	a, f := WGS84.A, WGS84.F
+	e := math.Sqrt(WGS84.E2())
+	n := f / (2 - f)
+	n2 := n * n
+	n3 := n * n2
+	n4 := n * n3
+	n5 := n * n4
+	n6 := n * n5
+	A := a / (1 + n) * (1 + n2/4 + n4/64 + n6/256)
+	alpha := [...]float64{
+		n/2 - 2*n2/3 + 5*n3/16 + 41*n4/180 - 127*n5/288 + 7891*n6/37800,
+		13*n2/48 - 3*n3/5 + 557*n4/1440 + 281*n5/630 - 1983433*n6/1935360,
+		61*n3/240 - 103*n4/140 + 15061*n5/26880 + 167603*n6/181440,
+		49561*n4/161280 - 179*n5/168 + 6601661*n6/7257600,
+		34729*n5/80640 - 3418889*n6/1995840,
+		212378941 * n6 / 319334400,
+	}
+
+	phi := radians(p.Lat)
+	lambda := radians(lon - centralMeridian)
+	tau := math.Tan(phi)
+	sigma := math.Sinh(e * math.Atanh(e*tau/math.Sqrt(1+tau*tau)))
+	tauPrime := tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+	sinLambda, cosLambda := math.Sincos(lambda)
+	xiPrime := math.Atan2(tauPrime, cosLambda)
+	etaPrime := math.Asinh(sinLambda / math.Sqrt(tauPrime*tauPrime+cosLambda*cosLambda))
+	xi, eta := xiPrime, etaPrime
+	for j, alphaJ := range alpha {
+		k := 2 * float64(j+1)
+		xi += alphaJ * math.Sin(k*xiPrime) * math.Cosh(k*etaPrime)
+		eta += alphaJ * math.Cos(k*xiPrime) * math.Sinh(k*etaPrime)
+	}
+
+	northing := utmScaleFactor * A * xi
+	if p.Lat < 0 {
+		northing += utmFalseNorthing
+	}
+	return UTM{
+		Zone:     zone,
+		Band:     band,
+		Easting:  utmScaleFactor*A*eta + utmFalseEasting,
+		Northing: northing,
+	}, nil
+}
+
+// String returns u in the form 31U 448252 5411933, with coordinates rounded
+// to the nearest meter.
+func (u UTM) String() string {
+	return fmt.Sprintf("%d%c %.0f %.0f", u.Zone, u.Band, u.Easting, u.Northing)
+}
@@ -0,0 +1,119 @@
+package geodesy
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	vincentyEpsilon       = 1e-12
+	vincentyMaxIterations = 200
+)
+
+// ErrVincentyNotConverged is returned when Vincenty's inverse formula fails
+// to converge, which happens for nearly antipodal points.
+var ErrVincentyNotConverged = errors.New("Vincenty's formula failed to converge")
+
+// VincentyDestination returns the point reached by traveling distance from
+// p along the geodesic on the WGS84 ellipsoid with initial bearing, and the
+// bearing on arrival.
+func (p LatLon) VincentyDestination(bearing, distance float64) (LatLon, float64) {
+	a, b, f := WGS84.A, WGS84.B(), WGS84.F
+	phi1, lambda1 := radians(p.Lat), radians(p.Lon)
+	alpha1 := radians(bearing)
+	sinAlpha1, cosAlpha1 := math.Sincos(alpha1)
+
+	tanU1 := (1 - f) * math.Tan(phi1)
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+	sigma1 := math.Atan2(tanU1, cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cos2Alpha := 1 - sinAlpha*sinAlpha
+	u2 := cos2Alpha * (a*a - b*b) / (b * b)
+	A := 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+	B := u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+
+	sigma := distance / (b * A)
+	var sinSigma, cosSigma, cos2SigmaM float64
+	for range vincentyMaxIterations {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sincos(sigma)
+		deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+		sigmaPrime := sigma
+		sigma = distance/(b*A) + deltaSigma
+		if math.Abs(sigma-sigmaPrime) <= vincentyEpsilon {
+			break
+		}
+	}
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+	sinSigma, cosSigma = math.Sincos(sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	phi2 := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-f)*math.Sqrt(sinAlpha*sinAlpha+x*x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	C := f / 16 * cos2Alpha * (4 + f*(4-3*cos2Alpha))
+	L := lambda - (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+	alpha2 := math.Atan2(sinAlpha, -x)
+
+	return LatLon{
+		Lat: degrees(phi2),
+		Lon: normalizeLon(degrees(lambda1 + L)),
+	}, normalizeBearing(degrees(alpha2))
+}
+
+// VincentyInverse returns the distance along the geodesic on the WGS84
+// ellipsoid from p to q, the initial bearing, and the bearing on arrival.
+// The bearings are NaN if p and q are the same point.
+func (p LatLon) VincentyInverse(q LatLon) (distance, initialBearing, finalBearing float64, err error) {
+	a, b, f := WGS84.A, WGS84.B(), WGS84.F
+	phi1, phi2 := radians(p.Lat), radians(q.Lat)
+	L := radians(q.Lon - p.Lon)
+
+	tanU1 := (1 - f) * math.Tan(phi1)
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+	tanU2 := (1 - f) * math.Tan(phi2)
+	cosU2 := 1 / math.Sqrt(1+tanU2*tanU2)
+	sinU2 := tanU2 * cosU2
+
+	lambda := L
+	var sinLambda, cosLambda, sinSigma, cosSigma, sigma, sinAlpha, cos2Alpha, cos2SigmaM float64
+	converged := false
+	for range vincentyMaxIterations {
+		sinLambda, cosLambda = math.Sincos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			return 0, math.NaN(), math.NaN(), nil
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha = cosU1 * cosU2 * sinLambda / sinSigma
+		cos2Alpha = 1 - sinAlpha*sinAlpha
+		if cos2Alpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cos2Alpha
+		} else {
+			// Both points are on the equator.
+			cos2SigmaM = 0
+		}
+		C := f / 16 * cos2Alpha * (4 + f*(4-3*cos2Alpha))
+		lambdaPrime := lambda
+		lambda = L + (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-lambdaPrime) <= vincentyEpsilon {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return 0, 0, 0, ErrVincentyNotConverged
+	}
+
+	u2 := cos2Alpha * (a*a - b*b) / (b * b)
+	A := 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+	B := u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+	distance = b * A * (sigma - deltaSigma)
+	alpha1 := math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+	alpha2 := math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda)
+	return distance, normalizeBearing(degrees(alpha1)), normalizeBearing(degrees(alpha2)), nil
+}
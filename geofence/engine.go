@@ -0,0 +1,244 @@
+package geofence
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/clock"
+	"github.com/twpayne/go-nmea/geodesy"
+	"github.com/twpayne/go-nmea/standard"
+	"github.com/twpayne/go-nmea/ublox"
+)
+
+type EventType int
+
+const (
+	EventTypeEnter EventType = iota
+	EventTypeExit
+	EventTypeDwell
+)
+
+// An Event is a change in the relationship between a position and a fence.
+type Event struct {
+	Type   EventType
+	Fence  *Fence
+	LatLon geodesy.LatLon
+	Time   time.Time
+}
+
+type fenceState struct {
+	inside       bool
+	enteredAt    time.Time
+	dwellEmitted bool
+}
+
+// An Engine tracks positions against fences.
+//
+// An Engine enters a fence when a position is more than the hysteresis
+// distance inside it, and exits it when a position is more than the
+// hysteresis distance outside it. The first accepted position generates an
+// enter event for each fence that it is inside.
+//
+// Positions are accepted only if their sentence reports a valid fix and
+// they meet the minimum fix quality requirements. FixQuality and HDOP
+// requirements are checked against the most recent GGA sentence and
+// horizontal accuracy requirements against the most recent GST sentence, so
+// positions are rejected until those sentences are seen.
+//
+// Events are timed with the time of the sentence that reported the position,
+// reconstructed with a clock.Clock, so that logs can be replayed. Until the
+// date is known, events are timed with the Engine's clock.
+type Engine struct {
+	fences                []*Fence
+	states                []*fenceState
+	hysteresis            float64
+	dwellTime             time.Duration
+	minFixQuality         int
+	maxHDOP               float64
+	maxHorizontalAccuracy float64
+	now                   func() time.Time
+	clock                 *clock.Clock
+	gga                   *standard.GGA
+	gst                   *standard.GST
+}
+
+type EngineOption func(*Engine)
+
+// WithClock sets the function that returns the current time, which is used
+// to time positions whose sentence time is not known. The default is
+// time.Now.
+func WithClock(now func() time.Time) EngineOption {
+	return func(e *Engine) {
+		e.now = now
+	}
+}
+
+// WithDwellTime sets the time after entering a fence after which a dwell
+// event is generated, if the fence has not been exited. The default, zero,
+// disables dwell events.
+func WithDwellTime(dwellTime time.Duration) EngineOption {
+	return func(e *Engine) {
+		e.dwellTime = dwellTime
+	}
+}
+
+// WithHysteresis sets the hysteresis distance in meters.
+func WithHysteresis(hysteresis float64) EngineOption {
+	return func(e *Engine) {
+		e.hysteresis = hysteresis
+	}
+}
+
+// WithMaxHDOP sets the maximum HDOP.
+func WithMaxHDOP(maxHDOP float64) EngineOption {
+	return func(e *Engine) {
+		e.maxHDOP = maxHDOP
+	}
+}
+
+// WithMaxHorizontalAccuracy sets the maximum horizontal accuracy, in meters,
+// computed from the latitude and longitude standard deviations in GST
+// sentences.
+func WithMaxHorizontalAccuracy(maxHorizontalAccuracy float64) EngineOption {
+	return func(e *Engine) {
+		e.maxHorizontalAccuracy = maxHorizontalAccuracy
+	}
+}
+
+// WithMinFixQuality sets the minimum GGA fix quality.
+func WithMinFixQuality(minFixQuality int) EngineOption {
+	return func(e *Engine) {
+		e.minFixQuality = minFixQuality
+	}
+}
+
+func NewEngine(fences []*Fence, options ...EngineOption) *Engine {
+	e := &Engine{
+		fences: slices.Clone(fences),
+		now:    time.Now,
+		clock:  clock.New(),
+	}
+	for _, option := range options {
+		option(e)
+	}
+	return e
+}
+
+// Update updates e with sentence and returns any events generated.
+func (e *Engine) Update(sentence nmea.Sentence) []Event {
+	t, ok := e.clock.Time(sentence)
+	if !ok {
+		t = e.now()
+	}
+	switch sentence := sentence.(type) {
+	case *standard.GGA:
+		e.gga = sentence
+	case *standard.GST:
+		e.gst = sentence
+		return nil
+	}
+	latLon, ok := geodesy.FromSentence(sentence)
+	if !ok || !validFix(sentence) || !e.accept() {
+		return nil
+	}
+	return e.UpdateLatLon(latLon, t)
+}
+
+// UpdateLatLon updates e with a position at time t without checking its
+// quality and returns any events generated. If t is the zero time then e's
+// clock is used.
+func (e *Engine) UpdateLatLon(latLon geodesy.LatLon, t time.Time) []Event {
+	if t.IsZero() {
+		t = e.now()
+	}
+	if e.states == nil {
+		e.states = make([]*fenceState, len(e.fences))
+		for i := range e.states {
+			e.states[i] = &fenceState{}
+		}
+	}
+	var events []Event
+	for i, fence := range e.fences {
+		state := e.states[i]
+		signedDistance := fence.Shape.SignedDistance(latLon)
+		var eventType EventType
+		switch {
+		case !state.inside && signedDistance < -e.hysteresis:
+			state.inside = true
+			state.enteredAt = t
+			state.dwellEmitted = false
+			eventType = EventTypeEnter
+		case state.inside && signedDistance > e.hysteresis:
+			state.inside = false
+			eventType = EventTypeExit
+		case state.inside && !state.dwellEmitted && e.dwellTime > 0 && t.Sub(state.enteredAt) >= e.dwellTime:
+			state.dwellEmitted = true
+			eventType = EventTypeDwell
+		default:
+			continue
+		}
+		events = append(events, Event{
+			Type:   eventType,
+			Fence:  fence,
+			LatLon: latLon,
+			Time:   t,
+		})
+	}
+	return events
+}
+
+// accept returns whether the most recent GGA and GST sentences meet e's
+// minimum fix quality requirements.
+func (e *Engine) accept() bool {
+	if e.minFixQuality > 0 || e.maxHDOP > 0 {
+		switch {
+		case e.gga == nil:
+			return false
+		case e.gga.FixQuality < e.minFixQuality:
+			return false
+		case e.maxHDOP > 0 && (!e.gga.HDOP.Valid || e.gga.HDOP.Value > e.maxHDOP):
+			return false
+		}
+	}
+	if e.maxHorizontalAccuracy > 0 {
+		if e.gst == nil || math.Hypot(e.gst.LatStdDev, e.gst.LonStdDev) > e.maxHorizontalAccuracy {
+			return false
+		}
+	}
+	return true
+}
+
+func (t EventType) String() string {
+	switch t {
+	case EventTypeEnter:
+		return "enter"
+	case EventTypeExit:
+		return "exit"
+	case EventTypeDwell:
+		return "dwell"
+	default:
+		return "unknown"
+	}
+}
+
+// validFix returns whether sentence reports a valid fix.
+func validFix(sentence nmea.Sentence) bool {
+	switch s := sentence.(type) {
+	case *standard.GGA:
+		return s.FixQuality > 0
+	case *standard.GLL:
+		return s.Status == 'A'
+	case *standard.GNS:
+		return slices.ContainsFunc(s.PosMode, func(posMode byte) bool {
+			return posMode != 'N'
+		})
+	case *standard.RMC:
+		return s.Status == 'A'
+	case *ublox.Position:
+		return s.NavStat != "NF"
+	default:
+		return true
+	}
+}
@@ -0,0 +1,142 @@
+// Package geofence generates enter, exit, and dwell events for fences from a
+// stream of positions.
+package geofence
+
+import (
+	"math"
+
+	"github.com/twpayne/go-nmea/geodesy"
+)
+
+// A Shape is a region on the Earth's surface.
+type Shape interface {
+	// SignedDistance returns the distance in meters from p to the shape's
+	// boundary. It is negative if p is inside the shape.
+	SignedDistance(p geodesy.LatLon) float64
+}
+
+// A Fence is a shape with an ID and arbitrary properties.
+type Fence struct {
+	ID         string
+	Shape      Shape
+	Properties map[string]any
+}
+
+// A Circle is the region within Radius meters of Center.
+type Circle struct {
+	Center geodesy.LatLon
+	Radius float64
+}
+
+// A Corridor is the region within Width/2 meters of a path.
+type Corridor struct {
+	Path  []geodesy.LatLon
+	Width float64
+}
+
+// A Polygon is the region inside its first ring and outside any subsequent
+// rings, which are holes. Rings may be open or closed.
+type Polygon struct {
+	Rings [][]geodesy.LatLon
+}
+
+// A MultiPolygon is the region inside any of its Polygons.
+type MultiPolygon struct {
+	Polygons []*Polygon
+}
+
+func (c *Circle) SignedDistance(p geodesy.LatLon) float64 {
+	return c.Center.HaversineDistance(p) - c.Radius
+}
+
+func (c *Corridor) SignedDistance(p geodesy.LatLon) float64 {
+	switch len(c.Path) {
+	case 0:
+		return math.Inf(1)
+	case 1:
+		return c.Path[0].HaversineDistance(p) - c.Width/2
+	}
+	projection := newLocalProjection(p)
+	x, y := projection.project(p)
+	distance := math.Inf(1)
+	x1, y1 := projection.project(c.Path[0])
+	for _, q := range c.Path[1:] {
+		x2, y2 := projection.project(q)
+		distance = min(distance, segmentDistance(x, y, x1, y1, x2, y2))
+		x1, y1 = x2, y2
+	}
+	return distance - c.Width/2
+}
+
+func (p *Polygon) SignedDistance(q geodesy.LatLon) float64 {
+	projection := newLocalProjection(q)
+	x, y := projection.project(q)
+	inside := false
+	distance := math.Inf(1)
+	for i, ring := range p.Rings {
+		if len(ring) == 0 {
+			continue
+		}
+		insideRing := false
+		x1, y1 := projection.project(ring[len(ring)-1])
+		for _, r := range ring {
+			x2, y2 := projection.project(r)
+			if (y1 > y) != (y2 > y) && x < x1+(y-y1)*(x2-x1)/(y2-y1) {
+				insideRing = !insideRing
+			}
+			distance = min(distance, segmentDistance(x, y, x1, y1, x2, y2))
+			x1, y1 = x2, y2
+		}
+		switch {
+		case i == 0:
+			inside = insideRing
+		case insideRing:
+			inside = false
+		}
+	}
+	if inside {
+		return -distance
+	}
+	return distance
+}
+
+func (m *MultiPolygon) SignedDistance(q geodesy.LatLon) float64 {
+	distance := math.Inf(1)
+	for _, polygon := range m.Polygons {
+		distance = min(distance, polygon.SignedDistance(q))
+	}
+	return distance
+}
+
+// A localProjection is an equirectangular projection in meters centered on
+// an origin, which is accurate for the distances between nearby points.
+type localProjection struct {
+	origin  geodesy.LatLon
+	metersX float64
+	metersY float64
+}
+
+func newLocalProjection(origin geodesy.LatLon) *localProjection {
+	metersPerDegree := geodesy.EarthRadius * math.Pi / 180
+	return &localProjection{
+		origin:  origin,
+		metersX: metersPerDegree * math.Cos(origin.Lat*math.Pi/180),
+		metersY: metersPerDegree,
+	}
+}
+
+func (p *localProjection) project(q geodesy.LatLon) (float64, float64) {
+	dLon := math.Mod(q.Lon-p.origin.Lon+540, 360) - 180
+	return dLon * p.metersX, (q.Lat - p.origin.Lat) * p.metersY
+}
+
+// segmentDistance returns the distance from (x, y) to the segment from (x1,
+// y1) to (x2, y2).
+func segmentDistance(x, y, x1, y1, x2, y2 float64) float64 {
+	dx, dy := x2-x1, y2-y1
+	t := 0.0
+	if length2 := dx*dx + dy*dy; length2 != 0 {
+		t = max(0, min(1, ((x-x1)*dx+(y-y1)*dy)/length2))
+	}
+	return math.Hypot(x-(x1+t*dx), y-(y1+t*dy))
+}
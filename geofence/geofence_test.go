@@ -0,0 +1,298 @@
+package geofence_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/geodesy"
+	"github.com/twpayne/go-nmea/geofence"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+const geoJSON = `{
+	"type": "FeatureCollection",
+	"features": [
+		{
+			"type": "Feature",
+			"id": "harbor",
+			"geometry": {
+				"type": "Polygon",
+				"coordinates": [
+					[[8.5, 47.3], [8.52, 47.3], [8.52, 47.32], [8.5, 47.32], [8.5, 47.3]],
+					[[8.505, 47.305], [8.51, 47.305], [8.51, 47.31], [8.505, 47.31], [8.505, 47.305]]
+				]
+			},
+			"properties": {
+				"name": "Harbor"
+			}
+		},
+		{
+			"type": "Feature",
+			"id": 2,
+			"geometry": {
+				"type": "Point",
+				"coordinates": [8.55, 47.35]
+			},
+			"properties": {
+				"radius": 100
+			}
+		},
+		{
+			"type": "Feature",
+			"id": "channel",
+			"geometry": {
+				"type": "LineString",
+				"coordinates": [[8.6, 47.3], [8.6, 47.4]]
+			},
+			"properties": {
+				"width": 200
+			}
+		}
+	]
+}`
+
+func TestParseGeoJSON(t *testing.T) {
+	fences, err := geofence.ParseGeoJSON([]byte(geoJSON))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(fences))
+	assert.Equal(t, "harbor", fences[0].ID)
+	assert.Equal(t, map[string]any{"name": "Harbor"}, fences[0].Properties)
+	assert.Equal(t, "2", fences[1].ID)
+	assert.Equal[geofence.Shape](t, &geofence.Circle{
+		Center: geodesy.LatLon{Lat: 47.35, Lon: 8.55},
+		Radius: 100,
+	}, fences[1].Shape)
+	assert.Equal[geofence.Shape](t, &geofence.Corridor{
+		Path:  []geodesy.LatLon{{Lat: 47.3, Lon: 8.6}, {Lat: 47.4, Lon: 8.6}},
+		Width: 200,
+	}, fences[2].Shape)
+
+	_, err = geofence.ParseGeoJSON([]byte(`{"type":"Feature","geometry":{"type":"Point","coordinates":[0,0]}}`))
+	assert.Error(t, err)
+	_, err = geofence.ParseGeoJSON([]byte(`{"type":"Feature","geometry":{"type":"GeometryCollection"}}`))
+	var unsupportedGeometryError *geofence.UnsupportedGeometryError
+	assert.True(t, errors.As(err, &unsupportedGeometryError))
+}
+
+func TestShapes(t *testing.T) {
+	fences, err := geofence.ParseGeoJSON([]byte(geoJSON))
+	assert.NoError(t, err)
+	polygon, circle, corridor := fences[0].Shape, fences[1].Shape, fences[2].Shape
+
+	for _, tc := range []struct {
+		name     string
+		shape    geofence.Shape
+		latLon   geodesy.LatLon
+		expected float64
+		delta    float64
+	}{
+		{
+			name:     "polygon_inside",
+			shape:    polygon,
+			latLon:   geodesy.LatLon{Lat: 47.301, Lon: 8.515},
+			expected: -111,
+			delta:    1,
+		},
+		{
+			name:     "polygon_outside",
+			shape:    polygon,
+			latLon:   geodesy.LatLon{Lat: 47.299, Lon: 8.515},
+			expected: 111,
+			delta:    1,
+		},
+		{
+			name:     "polygon_hole",
+			shape:    polygon,
+			latLon:   geodesy.LatLon{Lat: 47.3075, Lon: 8.5075},
+			expected: 188,
+			delta:    1,
+		},
+		{
+			name:     "circle_inside",
+			shape:    circle,
+			latLon:   geodesy.LatLon{Lat: 47.35, Lon: 8.55},
+			expected: -100,
+			delta:    1e-9,
+		},
+		{
+			name:     "corridor_outside",
+			shape:    corridor,
+			latLon:   geodesy.LatLon{Lat: 47.35, Lon: 8.605},
+			expected: 277,
+			delta:    1,
+		},
+		{
+			name:     "corridor_beyond_end",
+			shape:    corridor,
+			latLon:   geodesy.LatLon{Lat: 47.401, Lon: 8.6},
+			expected: 11,
+			delta:    1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.shape.SignedDistance(tc.latLon)
+			if actual < tc.expected-tc.delta || tc.expected+tc.delta < actual {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMultiPolygon(t *testing.T) {
+	fences, err := geofence.ParseGeoJSON([]byte(`{
+		"type": "Feature",
+		"id": "marina",
+		"geometry": {
+			"type": "MultiPolygon",
+			"coordinates": [
+				[[[8.7, 47.3], [8.71, 47.3], [8.71, 47.31], [8.7, 47.31], [8.7, 47.3]]],
+				[[[8.71, 47.3], [8.72, 47.3], [8.72, 47.31], [8.71, 47.31], [8.71, 47.3]]],
+				[[[8.75, 47.3], [8.76, 47.3], [8.76, 47.31], [8.75, 47.31], [8.75, 47.3]]]
+			]
+		}
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(fences))
+	assert.Equal(t, "marina", fences[0].ID)
+	multiPolygon, ok := fences[0].Shape.(*geofence.MultiPolygon)
+	assert.True(t, ok)
+	assert.Equal(t, 3, len(multiPolygon.Polygons))
+
+	west := geodesy.LatLon{Lat: 47.305, Lon: 8.705}
+	east := geodesy.LatLon{Lat: 47.305, Lon: 8.715}
+	between := geodesy.LatLon{Lat: 47.305, Lon: 8.735}
+	assert.True(t, multiPolygon.SignedDistance(west) < 0)
+	assert.True(t, multiPolygon.SignedDistance(east) < 0)
+	assert.True(t, multiPolygon.SignedDistance(between) > 0)
+
+	engine := geofence.NewEngine(fences)
+	events := engine.UpdateLatLon(west, time.Time{})
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, geofence.EventTypeEnter, events[0].Type)
+	assert.Zero(t, engine.UpdateLatLon(east, time.Time{}))
+	events = engine.UpdateLatLon(between, time.Time{})
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, geofence.EventTypeExit, events[0].Type)
+}
+
+func TestEngine(t *testing.T) {
+	fence := &geofence.Fence{
+		ID: "circle",
+		Shape: &geofence.Circle{
+			Center: geodesy.LatLon{Lat: 47.35, Lon: 8.55},
+			Radius: 100,
+		},
+	}
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	engine := geofence.NewEngine([]*geofence.Fence{fence},
+		geofence.WithClock(func() time.Time {
+			return now
+		}),
+		geofence.WithDwellTime(time.Minute),
+		geofence.WithHysteresis(10),
+		geofence.WithMinFixQuality(1),
+		geofence.WithMaxHDOP(2),
+		geofence.WithMaxHorizontalAccuracy(5),
+	)
+	center := fence.Shape.(*geofence.Circle).Center
+	gga := func(distance float64, fixQuality int, hdop float64) *standard.GGA {
+		latLon := center.Destination(0, distance)
+		return &standard.GGA{
+			Address:    nmea.NewAddress("GPGGA"),
+			Lat:        nmea.NewOptional(latLon.Lat),
+			Lon:        nmea.NewOptional(latLon.Lon),
+			FixQuality: fixQuality,
+			HDOP:       nmea.NewOptional(hdop),
+		}
+	}
+	eventTypes := func(events []geofence.Event) []geofence.EventType {
+		var eventTypes []geofence.EventType
+		for _, event := range events {
+			assert.Equal(t, fence, event.Fence)
+			assert.Equal(t, now, event.Time)
+			eventTypes = append(eventTypes, event.Type)
+		}
+		return eventTypes
+	}
+
+	// Positions are rejected until a GST sentence is seen.
+	assert.Zero(t, engine.Update(gga(0, 1, 1)))
+	assert.Zero(t, engine.Update(&standard.GST{LatStdDev: 3, LonStdDev: 4}))
+	assert.Equal(t, []geofence.EventType{geofence.EventTypeEnter}, eventTypes(engine.Update(gga(0, 1, 1))))
+
+	// Positions within the hysteresis distance do not exit.
+	assert.Zero(t, engine.Update(gga(105, 1, 1)))
+
+	// Poor fixes are rejected.
+	assert.Zero(t, engine.Update(gga(200, 0, 1)))
+	assert.Zero(t, engine.Update(gga(200, 1, 3)))
+	assert.Zero(t, engine.Update(&standard.GST{LatStdDev: 5, LonStdDev: 5}))
+	assert.Zero(t, engine.Update(gga(200, 1, 1)))
+	assert.Zero(t, engine.Update(&standard.GST{LatStdDev: 1, LonStdDev: 1}))
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, []geofence.EventType{geofence.EventTypeDwell}, eventTypes(engine.Update(gga(50, 1, 1))))
+	now = now.Add(time.Minute)
+	assert.Zero(t, engine.Update(gga(50, 1, 1)))
+
+	assert.Equal(t, []geofence.EventType{geofence.EventTypeExit}, eventTypes(engine.Update(gga(111, 1, 1))))
+	assert.Zero(t, engine.Update(gga(95, 1, 1)))
+	assert.Equal(t, []geofence.EventType{geofence.EventTypeEnter}, eventTypes(engine.Update(gga(89, 1, 1))))
+
+	// Other sentences use the quality of the most recent GGA sentence.
+	latLon := center.Destination(90, 500)
+	assert.Equal(t, []geofence.EventType{geofence.EventTypeExit}, eventTypes(engine.Update(&standard.RMC{
+		Status: 'A',
+		Lat:    nmea.NewOptional(latLon.Lat),
+		Lon:    nmea.NewOptional(latLon.Lon),
+	})))
+}
+
+func TestEngineSentenceTime(t *testing.T) {
+	fence := &geofence.Fence{
+		ID: "circle",
+		Shape: &geofence.Circle{
+			Center: geodesy.LatLon{Lat: 47.35, Lon: 8.55},
+			Radius: 100,
+		},
+	}
+	wallTime := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	engine := geofence.NewEngine([]*geofence.Fence{fence},
+		geofence.WithClock(func() time.Time {
+			return wallTime
+		}),
+		geofence.WithDwellTime(time.Minute),
+	)
+	center := fence.Shape.(*geofence.Circle).Center
+	gga := func(timeOfDay nmea.TimeOfDay) *standard.GGA {
+		return &standard.GGA{
+			Address:    nmea.NewAddress("GPGGA"),
+			TimeOfDay:  nmea.NewOptional(timeOfDay),
+			Lat:        nmea.NewOptional(center.Lat),
+			Lon:        nmea.NewOptional(center.Lon),
+			FixQuality: 1,
+		}
+	}
+
+	events := engine.Update(&standard.RMC{
+		Address:   nmea.NewAddress("GPRMC"),
+		TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{Hour: 12, Minute: 35, Second: 19}),
+		Status:    'A',
+		Lat:       nmea.NewOptional(center.Lat),
+		Lon:       nmea.NewOptional(center.Lon),
+		Date:      nmea.NewOptional(nmea.Date{Year: 2015, Month: time.March, Day: 23}),
+	})
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, geofence.EventTypeEnter, events[0].Type)
+	assert.Equal(t, time.Date(2015, time.March, 23, 12, 35, 19, 0, time.UTC), events[0].Time)
+
+	assert.Zero(t, engine.Update(gga(nmea.TimeOfDay{Hour: 12, Minute: 36, Second: 18})))
+	events = engine.Update(gga(nmea.TimeOfDay{Hour: 12, Minute: 36, Second: 19}))
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, geofence.EventTypeDwell, events[0].Type)
+	assert.Equal(t, time.Date(2015, time.March, 23, 12, 36, 19, 0, time.UTC), events[0].Time)
+}
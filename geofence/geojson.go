@@ -0,0 +1,163 @@
+package geofence
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/twpayne/go-nmea/geodesy"
+)
+
+// An UnsupportedGeometryError is returned when a GeoJSON feature has a
+// geometry that cannot be converted to a fence.
+type UnsupportedGeometryError struct {
+	Type string
+}
+
+func (e *UnsupportedGeometryError) Error() string {
+	return fmt.Sprintf("%s: unsupported geometry", e.Type)
+}
+
+type geoJSONFeature struct {
+	Type       string           `json:"type"`
+	ID         any              `json:"id"`
+	Geometry   geoJSONGeometry  `json:"geometry"`
+	Properties map[string]any   `json:"properties"`
+	Features   []geoJSONFeature `json:"features"`
+}
+
+type geoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// ParseGeoJSON returns the fences in data, which is a GeoJSON
+// FeatureCollection or Feature. Polygon and MultiPolygon geometries are
+// converted to polygons and multipolygons, Point geometries with a radius
+// property, in meters, to circles, and LineString geometries with a width
+// property, in meters, to corridors. Each feature is converted to one fence.
+// Fence IDs are taken from the feature's id member, if present.
+func ParseGeoJSON(data []byte) ([]*Fence, error) {
+	var feature geoJSONFeature
+	if err := json.Unmarshal(data, &feature); err != nil {
+		return nil, err
+	}
+	switch feature.Type {
+	case "FeatureCollection":
+		var fences []*Fence
+		for _, feature := range feature.Features {
+			fence, err := feature.fence()
+			if err != nil {
+				return nil, err
+			}
+			fences = append(fences, fence)
+		}
+		return fences, nil
+	case "Feature":
+		fence, err := feature.fence()
+		if err != nil {
+			return nil, err
+		}
+		return []*Fence{fence}, nil
+	default:
+		return nil, fmt.Errorf("%s: unsupported GeoJSON type", feature.Type)
+	}
+}
+
+func (f *geoJSONFeature) fence() (*Fence, error) {
+	var id string
+	switch featureID := f.ID.(type) {
+	case string:
+		id = featureID
+	case float64:
+		id = strconv.FormatFloat(featureID, 'f', -1, 64)
+	}
+	var shape Shape
+	switch f.Geometry.Type {
+	case "LineString":
+		var coordinates [][]float64
+		if err := json.Unmarshal(f.Geometry.Coordinates, &coordinates); err != nil {
+			return nil, err
+		}
+		width, err := f.floatProperty("width")
+		if err != nil {
+			return nil, err
+		}
+		shape = &Corridor{
+			Path:  latLons(coordinates),
+			Width: width,
+		}
+	case "MultiPolygon":
+		var coordinates [][][][]float64
+		if err := json.Unmarshal(f.Geometry.Coordinates, &coordinates); err != nil {
+			return nil, err
+		}
+		multiPolygon := &MultiPolygon{
+			Polygons: make([]*Polygon, 0, len(coordinates)),
+		}
+		for _, polygonCoordinates := range coordinates {
+			multiPolygon.Polygons = append(multiPolygon.Polygons, newPolygon(polygonCoordinates))
+		}
+		shape = multiPolygon
+	case "Point":
+		var coordinates []float64
+		if err := json.Unmarshal(f.Geometry.Coordinates, &coordinates); err != nil {
+			return nil, err
+		}
+		if len(coordinates) < 2 {
+			return nil, fmt.Errorf("%s: invalid Point coordinates", id)
+		}
+		radius, err := f.floatProperty("radius")
+		if err != nil {
+			return nil, err
+		}
+		shape = &Circle{
+			Center: geodesy.LatLon{Lat: coordinates[1], Lon: coordinates[0]},
+			Radius: radius,
+		}
+	case "Polygon":
+		var coordinates [][][]float64
+		if err := json.Unmarshal(f.Geometry.Coordinates, &coordinates); err != nil {
+			return nil, err
+		}
+		shape = newPolygon(coordinates)
+	default:
+		return nil, &UnsupportedGeometryError{
+			Type: f.Geometry.Type,
+		}
+	}
+	return &Fence{
+		ID:         id,
+		Shape:      shape,
+		Properties: f.Properties,
+	}, nil
+}
+
+func (f *geoJSONFeature) floatProperty(key string) (float64, error) {
+	value, ok := f.Properties[key].(float64)
+	if !ok {
+		return 0, fmt.Errorf("%s: missing or invalid %s property", f.Geometry.Type, key)
+	}
+	return value, nil
+}
+
+func latLons(coordinates [][]float64) []geodesy.LatLon {
+	result := make([]geodesy.LatLon, 0, len(coordinates))
+	for _, c := range coordinates {
+		if len(c) < 2 {
+			continue
+		}
+		result = append(result, geodesy.LatLon{Lat: c[1], Lon: c[0]})
+	}
+	return result
+}
+
+func newPolygon(coordinates [][][]float64) *Polygon {
+	rings := make([][]geodesy.LatLon, 0, len(coordinates))
+	for _, ring := range coordinates {
+		rings = append(rings, latLons(ring))
+	}
+	return &Polygon{
+		Rings: rings,
+	}
+}
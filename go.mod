@@ -1,6 +1,6 @@
 module github.com/twpayne/go-nmea
 
-go 1.21
+go 1.23
 
 require github.com/alecthomas/assert/v2 v2.8.1
 
@@ -0,0 +1,55 @@
+package hemisphere
+
+import "github.com/twpayne/go-nmea"
+
+// GBS flags.
+const (
+	GBSFlagGood    = 0
+	GBSFlagWarning = 1
+	GBSFlagBad     = 2
+)
+
+// A GBS is a satellite fault detection sentence, which extends the
+// standard GBS sentence with a flag that summarizes the integrity of the
+// solution. Errors are in meters.
+type GBS struct {
+	nmea.Address
+	TimeOfDay                     nmea.TimeOfDay
+	LatError                      nmea.Optional[float64]
+	LonError                      nmea.Optional[float64]
+	AltError                      nmea.Optional[float64]
+	FailedSatelliteID             nmea.Optional[int]
+	ProbabilityOfMissedDetection  nmea.Optional[float64]
+	BiasEstimate                  nmea.Optional[float64]
+	BiasEstimateStandardDeviation nmea.Optional[float64]
+	Flag                          int
+}
+
+func ParseGBS(addr string, tok *nmea.Tokenizer) (*GBS, error) {
+	var g GBS
+	g.Address = nmea.NewAddress(addr)
+	g.TimeOfDay = tok.CommaTimeOfDay()
+	g.LatError = tok.CommaOptionalUnsignedFloat()
+	g.LonError = tok.CommaOptionalUnsignedFloat()
+	g.AltError = tok.CommaOptionalUnsignedFloat()
+	g.FailedSatelliteID = tok.CommaOptionalUnsignedInt()
+	g.ProbabilityOfMissedDetection = tok.CommaOptionalUnsignedFloat()
+	g.BiasEstimate = tok.CommaOptionalFloat()
+	g.BiasEstimateStandardDeviation = tok.CommaOptionalUnsignedFloat()
+	g.Flag = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &g, tok.Err()
+}
+
+func (g *GBS) Encode(b *nmea.Builder) {
+	b.CommaString("GBS")
+	b.CommaTimeOfDay(g.TimeOfDay, 2)
+	b.CommaOptionalFloat(g.LatError, 2)
+	b.CommaOptionalFloat(g.LonError, 2)
+	b.CommaOptionalFloat(g.AltError, 2)
+	b.CommaOptionalInt(g.FailedSatelliteID)
+	b.CommaOptionalFloat(g.ProbabilityOfMissedDetection, 2)
+	b.CommaOptionalFloat(g.BiasEstimate, 2)
+	b.CommaOptionalFloat(g.BiasEstimateStandardDeviation, 2)
+	b.CommaInt(g.Flag)
+}
@@ -0,0 +1,42 @@
+// Package hemisphere parses and encodes Hemisphere GNSS PSAT NMEA sentences,
+// as described in the Hemisphere GNSS Technical Reference Manual.
+package hemisphere
+
+import (
+	"github.com/twpayne/go-nmea"
+)
+
+var sentenceParserMap = map[string]nmea.SentenceParser{
+	"GBS":   nmea.MakeSentenceParser(ParseGBS),
+	"HPR":   nmea.MakeSentenceParser(ParseHPR),
+	"INTLT": nmea.MakeSentenceParser(ParseINTLT),
+}
+
+type UnknownMessageTypeError struct {
+	MessageType string
+}
+
+func (e *UnknownMessageTypeError) Error() string {
+	return e.MessageType + ": unknown message type"
+}
+
+func ParseSentence(addr string, tok *nmea.Tokenizer) (nmea.Sentence, error) {
+	messageType := tok.CommaString()
+	if err := tok.Err(); err != nil {
+		return nil, err
+	}
+	sentenceParser := sentenceParserMap[messageType]
+	if sentenceParser != nil {
+		return sentenceParser(addr, tok)
+	}
+	return nil, &UnknownMessageTypeError{
+		MessageType: messageType,
+	}
+}
+
+func SentenceParserFunc(addr string) nmea.SentenceParser {
+	if addr != "PSAT" {
+		return nil
+	}
+	return ParseSentence
+}
@@ -0,0 +1,76 @@
+package hemisphere_test
+
+import (
+	"testing"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/hemisphere"
+	"github.com/twpayne/go-nmea/nmeatest"
+)
+
+func TestSentenceParserFunc(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(hemisphere.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$PSAT,GBS,015003.00,0.31,0.26,0.65,,,,,0*42",
+				Expected: &hemisphere.GBS{
+					Address: nmea.NewAddress("PSAT"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   1,
+						Minute: 50,
+						Second: 3,
+					},
+					LatError: nmea.NewOptional(0.31),
+					LonError: nmea.NewOptional(0.26),
+					AltError: nmea.NewOptional(0.65),
+					Flag:     hemisphere.GBSFlagGood,
+				},
+			},
+			{
+				S: "$PSAT,GBS,015003.00,1.52,1.81,3.40,12,0.01,-21.34,6.12,1*4F",
+				Expected: &hemisphere.GBS{
+					Address: nmea.NewAddress("PSAT"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   1,
+						Minute: 50,
+						Second: 3,
+					},
+					LatError:                      nmea.NewOptional(1.52),
+					LonError:                      nmea.NewOptional(1.81),
+					AltError:                      nmea.NewOptional(3.4),
+					FailedSatelliteID:             nmea.NewOptional(12),
+					ProbabilityOfMissedDetection:  nmea.NewOptional(0.01),
+					BiasEstimate:                  nmea.NewOptional(-21.34),
+					BiasEstimateStandardDeviation: nmea.NewOptional(6.12),
+					Flag:                          hemisphere.GBSFlagWarning,
+				},
+			},
+			{
+				S: "$PSAT,HPR,130214.00,305.66,-1.12,,N*10",
+				Expected: &hemisphere.HPR{
+					Address: nmea.NewAddress("PSAT"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   13,
+						Minute: 2,
+						Second: 14,
+					},
+					Heading: nmea.NewOptional(305.66),
+					Pitch:   nmea.NewOptional(-1.12),
+					Type:    hemisphere.HPRTypeGNSS,
+				},
+			},
+			{
+				S: "$PSAT,INTLT,-0.528,1.132*52",
+				Expected: &hemisphere.INTLT{
+					Address: nmea.NewAddress("PSAT"),
+					Pitch:   -0.528,
+					Roll:    1.132,
+				},
+			},
+		})
+}
@@ -0,0 +1,41 @@
+package hemisphere
+
+import "github.com/twpayne/go-nmea"
+
+// HPR types.
+const (
+	HPRTypeGNSS byte = 'N'
+	HPRTypeGyro byte = 'G'
+)
+
+// An HPR is a heading, pitch, and roll sentence. Angles are in degrees.
+// Type indicates whether the heading is derived from GNSS or from the gyro.
+type HPR struct {
+	nmea.Address
+	TimeOfDay nmea.TimeOfDay
+	Heading   nmea.Optional[float64]
+	Pitch     nmea.Optional[float64]
+	Roll      nmea.Optional[float64]
+	Type      byte
+}
+
+func ParseHPR(addr string, tok *nmea.Tokenizer) (*HPR, error) {
+	var h HPR
+	h.Address = nmea.NewAddress(addr)
+	h.TimeOfDay = tok.CommaTimeOfDay()
+	h.Heading = tok.CommaOptionalUnsignedFloat()
+	h.Pitch = tok.CommaOptionalFloat()
+	h.Roll = tok.CommaOptionalFloat()
+	h.Type = tok.CommaOneByteOf("GN")
+	tok.EndOfData()
+	return &h, tok.Err()
+}
+
+func (h *HPR) Encode(b *nmea.Builder) {
+	b.CommaString("HPR")
+	b.CommaTimeOfDay(h.TimeOfDay, 2)
+	b.CommaOptionalFloat(h.Heading, 2)
+	b.CommaOptionalFloat(h.Pitch, 2)
+	b.CommaOptionalFloat(h.Roll, 2)
+	b.CommaByte(h.Type)
+}
@@ -0,0 +1,25 @@
+package hemisphere
+
+import "github.com/twpayne/go-nmea"
+
+// An INTLT is an internal tilt sensor sentence. Angles are in degrees.
+type INTLT struct {
+	nmea.Address
+	Pitch float64
+	Roll  float64
+}
+
+func ParseINTLT(addr string, tok *nmea.Tokenizer) (*INTLT, error) {
+	var i INTLT
+	i.Address = nmea.NewAddress(addr)
+	i.Pitch = tok.CommaFloat()
+	i.Roll = tok.CommaFloat()
+	tok.EndOfData()
+	return &i, tok.Err()
+}
+
+func (i *INTLT) Encode(b *nmea.Builder) {
+	b.CommaString("INTLT")
+	b.CommaFloat(i.Pitch, 3)
+	b.CommaFloat(i.Roll, 3)
+}
@@ -0,0 +1,292 @@
+// Package ingest parses large volumes of NMEA sentences concurrently.
+//
+// Input is read in chunks aligned to line boundaries. Chunks are parsed by
+// independent workers, each with its own nmea.Parser, and results are
+// delivered in input order.
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/twpayne/go-nmea"
+)
+
+const defaultChunkSize = 1 << 20
+
+type Result struct {
+	Offset   int64
+	Line     int
+	Raw      []byte
+	Sentence nmea.Sentence
+	Err      error
+}
+
+type Progress struct {
+	Bytes  int64
+	Lines  int
+	Errors int
+}
+
+type options struct {
+	chunkSize    int
+	workers      int
+	newParser    func() *nmea.Parser
+	progressFunc func(Progress)
+}
+
+type Option func(*options)
+
+// WithChunkSize sets the size of the chunks that are read from the input.
+// Lines longer than the chunk size are read whole.
+func WithChunkSize(chunkSize int) Option {
+	return func(o *options) {
+		o.chunkSize = chunkSize
+	}
+}
+
+// WithParserOptions sets the options used to create each worker's parser.
+func WithParserOptions(parserOptions ...nmea.ParserOption) Option {
+	return func(o *options) {
+		o.newParser = func() *nmea.Parser {
+			return nmea.NewParser(parserOptions...)
+		}
+	}
+}
+
+// WithProgressFunc sets a function that is called after each chunk is
+// delivered with the cumulative progress.
+func WithProgressFunc(progressFunc func(Progress)) Option {
+	return func(o *options) {
+		o.progressFunc = progressFunc
+	}
+}
+
+// WithWorkers sets the number of workers. The default is runtime.GOMAXPROCS.
+func WithWorkers(workers int) Option {
+	return func(o *options) {
+		o.workers = workers
+	}
+}
+
+// A Stream delivers the results of parsing an input.
+type Stream struct {
+	results chan Result
+	err     error
+}
+
+type chunk struct {
+	index     int
+	offset    int64
+	firstLine int
+	data      []byte
+}
+
+type parsedChunk struct {
+	index   int
+	size    int
+	lines   int
+	errors  int
+	results []Result
+}
+
+// Parse parses each non-blank line of r as a sentence.
+func Parse(ctx context.Context, r io.Reader, opts ...Option) *Stream {
+	o := options{
+		chunkSize: defaultChunkSize,
+		workers:   runtime.GOMAXPROCS(0),
+		newParser: func() *nmea.Parser {
+			return nmea.NewParser()
+		},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	o.chunkSize = max(o.chunkSize, 1)
+	o.workers = max(o.workers, 1)
+	s := &Stream{
+		results: make(chan Result, o.workers),
+	}
+	go s.run(ctx, r, &o)
+	return s
+}
+
+// Results returns the channel on which results are delivered. It is closed
+// when the input is exhausted, an error occurs, or the context is canceled.
+func (s *Stream) Results() <-chan Result {
+	return s.results
+}
+
+// Err returns the error, if any, that stopped the stream. It must only be
+// called after the results channel is closed.
+func (s *Stream) Err() error {
+	return s.err
+}
+
+func (s *Stream) run(ctx context.Context, r io.Reader, o *options) {
+	defer close(s.results)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// inFlight limits the number of chunks that have been read but not yet
+	// delivered, which bounds memory use when a worker is slow.
+	inFlight := make(chan struct{}, 2*o.workers)
+	chunks := make(chan chunk, o.workers)
+	var readErr error
+	go func() {
+		defer close(chunks)
+		readErr = readChunks(ctx, r, o.chunkSize, inFlight, chunks)
+	}()
+
+	parsedChunks := make(chan parsedChunk, o.workers)
+	var wg sync.WaitGroup
+	for i := 0; i < o.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			parser := o.newParser()
+			for c := range chunks {
+				select {
+				case parsedChunks <- parseChunk(parser, c):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(parsedChunks)
+	}()
+
+	var progress Progress
+	pending := make(map[int]parsedChunk)
+	next := 0
+	for pc := range parsedChunks {
+		pending[pc.index] = pc
+		for {
+			pc, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			for _, result := range pc.results {
+				select {
+				case s.results <- result:
+				case <-ctx.Done():
+					s.err = ctx.Err()
+					return
+				}
+			}
+			<-inFlight
+			progress.Bytes += int64(pc.size)
+			progress.Lines += pc.lines
+			progress.Errors += pc.errors
+			if o.progressFunc != nil {
+				o.progressFunc(progress)
+			}
+			next++
+		}
+	}
+
+	switch {
+	case ctx.Err() != nil:
+		s.err = ctx.Err()
+	case readErr != nil:
+		s.err = readErr
+	}
+}
+
+// readChunks reads chunks from r, each ending at a line boundary, and sends
+// them to chunks.
+func readChunks(ctx context.Context, r io.Reader, chunkSize int, inFlight chan<- struct{}, chunks chan<- chunk) error {
+	var carry []byte
+	var offset int64
+	line := 1
+	for index := 0; ; index++ {
+		data := make([]byte, len(carry), len(carry)+chunkSize)
+		copy(data, carry)
+		carry = nil
+		var err error
+		for {
+			var n int
+			n, err = io.ReadFull(r, data[len(data):cap(data)])
+			data = data[:len(data)+n]
+			if err != nil {
+				break
+			}
+			if i := bytes.LastIndexByte(data, '\n'); i != -1 {
+				carry = data[i+1:]
+				data = data[:i+1]
+				break
+			}
+			data = append(data, make([]byte, chunkSize)...)[:len(data)]
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			err = nil
+			if len(data) == 0 {
+				return nil
+			}
+		} else if err != nil {
+			return err
+		}
+		select {
+		case inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		select {
+		case chunks <- chunk{
+			index:     index,
+			offset:    offset,
+			firstLine: line,
+			data:      data,
+		}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		offset += int64(len(data))
+		line += bytes.Count(data, []byte{'\n'})
+		if carry == nil {
+			return nil
+		}
+	}
+}
+
+func parseChunk(parser *nmea.Parser, c chunk) parsedChunk {
+	pc := parsedChunk{
+		index: c.index,
+		size:  len(c.data),
+	}
+	offset := c.offset
+	line := c.firstLine
+	for data := c.data; len(data) > 0; {
+		raw := data
+		if i := bytes.IndexByte(data, '\n'); i != -1 {
+			raw = data[:i+1]
+		}
+		data = data[len(raw):]
+		if len(bytes.TrimSpace(raw)) != 0 {
+			sentence, err := parser.Parse(raw)
+			if err != nil {
+				pc.errors++
+			}
+			pc.results = append(pc.results, Result{
+				Offset:   offset,
+				Line:     line,
+				Raw:      raw,
+				Sentence: sentence,
+				Err:      err,
+			})
+		}
+		offset += int64(len(raw))
+		line++
+		pc.lines++
+	}
+	return pc
+}
@@ -0,0 +1,82 @@
+package ingest_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/ingest"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+func TestParse(t *testing.T) {
+	var sb strings.Builder
+	n := 1000
+	for i := 0; i < n; i++ {
+		if i%100 == 99 {
+			sb.WriteString("$GPTXT,invalid*00\r\n\r\n")
+			continue
+		}
+		sentence := fmt.Sprintf("GPTXT,01,01,02,%d", i)
+		var checksum byte
+		for _, c := range []byte(sentence) {
+			checksum ^= c
+		}
+		fmt.Fprintf(&sb, "$%s*%02X\r\n", sentence, checksum)
+	}
+	input := sb.String()
+
+	var progress []ingest.Progress
+	stream := ingest.Parse(context.Background(), strings.NewReader(input),
+		ingest.WithChunkSize(64),
+		ingest.WithWorkers(4),
+		ingest.WithParserOptions(
+			nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+		),
+		ingest.WithProgressFunc(func(p ingest.Progress) {
+			progress = append(progress, p)
+		}),
+	)
+	i := 0
+	line := 1
+	offset := int64(0)
+	for result := range stream.Results() {
+		assert.Equal(t, line, result.Line)
+		assert.Equal(t, offset, result.Offset)
+		assert.Equal(t, input[offset:offset+int64(len(result.Raw))], string(result.Raw))
+		if i%100 == 99 {
+			assert.Error(t, result.Err)
+			line += 2
+			offset += int64(len(result.Raw)) + 2
+		} else {
+			assert.NoError(t, result.Err)
+			assert.Equal(t, strconv.Itoa(i), result.Sentence.(*standard.TXT).Text) //nolint:forcetypeassert
+			line++
+			offset += int64(len(result.Raw))
+		}
+		i++
+	}
+	assert.NoError(t, stream.Err())
+	assert.Equal(t, n, i)
+	assert.Equal(t, ingest.Progress{
+		Bytes:  int64(len(input)),
+		Lines:  n + n/100,
+		Errors: n / 100,
+	}, progress[len(progress)-1])
+}
+
+func TestParseCancel(t *testing.T) {
+	input := strings.Repeat("$GPTXT,01,01,02,test*5B\r\n", 1000)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := ingest.Parse(ctx, strings.NewReader(input), ingest.WithChunkSize(64))
+	<-stream.Results()
+	cancel()
+	for range stream.Results() { //nolint:revive
+	}
+	assert.IsError(t, stream.Err(), context.Canceled)
+}
@@ -0,0 +1,151 @@
+package nmea
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"iter"
+	"os"
+	"slices"
+)
+
+// A RawSentence is a sentence and the raw bytes, including any line ending,
+// from which it was parsed.
+type RawSentence struct {
+	Raw      []byte
+	Sentence Sentence
+}
+
+// Sentences returns an iterator over the sentences in r, one per line. Blank
+// lines are skipped. Errors, including parse errors, are yielded with a nil
+// sentence and iteration continues, except after read errors.
+func Sentences(p *Parser, r io.Reader) iter.Seq2[Sentence, error] {
+	return func(yield func(Sentence, error) bool) {
+		for rawSentence, err := range WithRaw(p, r) {
+			if !yield(rawSentence.Sentence, err) {
+				return
+			}
+		}
+	}
+}
+
+// SentencesFromFile returns an iterator over the sentences in the file
+// name. The file is closed when iteration stops.
+func SentencesFromFile(p *Parser, name string) iter.Seq2[Sentence, error] {
+	return func(yield func(Sentence, error) bool) {
+		file, err := os.Open(name)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer file.Close()
+		for sentence, err := range Sentences(p, file) {
+			if !yield(sentence, err) {
+				return
+			}
+		}
+	}
+}
+
+// WithRaw is like Sentences but also yields the raw bytes of each sentence.
+func WithRaw(p *Parser, r io.Reader) iter.Seq2[RawSentence, error] {
+	return func(yield func(RawSentence, error) bool) {
+		scanner := bufio.NewScanner(r)
+		scanner.Split(scanLinesWithLineEndings)
+		for scanner.Scan() {
+			line := scanner.Bytes()
+			if len(bytes.TrimSpace(line)) == 0 {
+				continue
+			}
+			raw := slices.Clone(line)
+			sentence, err := p.Parse(raw)
+			if !yield(RawSentence{Raw: raw, Sentence: sentence}, err) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield(RawSentence{}, err)
+		}
+	}
+}
+
+// OfType returns an iterator over the sentences in seq of type S. Errors are
+// yielded with the zero value of S.
+func OfType[S Sentence](seq iter.Seq2[Sentence, error]) iter.Seq2[S, error] {
+	return func(yield func(S, error) bool) {
+		for sentence, err := range seq {
+			if err != nil {
+				var zero S
+				if !yield(zero, err) {
+					return
+				}
+				continue
+			}
+			if s, ok := sentence.(S); ok {
+				if !yield(s, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ByTalker returns an iterator over the sentences in seq with any of the
+// given talkers. Errors are yielded unchanged.
+func ByTalker(seq iter.Seq2[Sentence, error], talkers ...string) iter.Seq2[Sentence, error] {
+	return filter(seq, func(sentence Sentence) bool {
+		return slices.Contains(talkers, sentence.GetAddress().Talker())
+	})
+}
+
+// ByAddress returns an iterator over the sentences in seq with any of the
+// given addresses. Errors are yielded unchanged.
+func ByAddress(seq iter.Seq2[Sentence, error], addresses ...string) iter.Seq2[Sentence, error] {
+	return filter(seq, func(sentence Sentence) bool {
+		return slices.Contains(addresses, sentence.GetAddress().String())
+	})
+}
+
+// SkipErrors returns an iterator over the values in seq that were yielded
+// without an error.
+func SkipErrors[V any](seq iter.Seq2[V, error]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for value, err := range seq {
+			if err != nil {
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func filter(seq iter.Seq2[Sentence, error], f func(Sentence) bool) iter.Seq2[Sentence, error] {
+	return func(yield func(Sentence, error) bool) {
+		for sentence, err := range seq {
+			if err == nil && !f(sentence) {
+				continue
+			}
+			if !yield(sentence, err) {
+				return
+			}
+		}
+	}
+}
+
+// scanLinesWithLineEndings is like bufio.ScanLines but includes line endings
+// in the returned tokens, so that the parser's line ending discipline
+// applies.
+func scanLinesWithLineEndings(data []byte, atEOF bool) (int, []byte, error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[:i+1], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
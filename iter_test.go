@@ -0,0 +1,97 @@
+package nmea_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+const iterTestData = "" +
+	"$GPGGA,092725.00,4717.11399,N,00833.91590,E,1,08,1.01,499.6,M,48.0,M,,*5B\r\n" +
+	"\r\n" +
+	"$GNTXT,01,01,02,hello*31\r\n" +
+	"$GPTXT,01,01,02,world*00\r\n" +
+	"$GPTXT,01,01,02,world*2F\r\n"
+
+func newIterTestParser() *nmea.Parser {
+	return nmea.NewParser(
+		nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+	)
+}
+
+func TestSentences(t *testing.T) {
+	var addresses []string
+	var errs int
+	for sentence, err := range nmea.Sentences(newIterTestParser(), strings.NewReader(iterTestData)) {
+		if err != nil {
+			errs++
+			continue
+		}
+		addresses = append(addresses, sentence.GetAddress().String())
+	}
+	assert.Equal(t, []string{"GPGGA", "GNTXT", "GPTXT"}, addresses)
+	assert.Equal(t, 1, errs)
+}
+
+func TestSentencesFromFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "test.nmea")
+	assert.NoError(t, os.WriteFile(name, []byte(iterTestData), 0o666))
+	var n int
+	for range nmea.SkipErrors(nmea.SentencesFromFile(newIterTestParser(), name)) {
+		n++
+	}
+	assert.Equal(t, 3, n)
+
+	for _, err := range nmea.SentencesFromFile(newIterTestParser(), filepath.Join(t.TempDir(), "missing")) {
+		assert.Error(t, err)
+	}
+}
+
+func TestOfType(t *testing.T) {
+	var ggas []*standard.GGA
+	for gga := range nmea.SkipErrors(nmea.OfType[*standard.GGA](nmea.Sentences(newIterTestParser(), strings.NewReader(iterTestData)))) {
+		ggas = append(ggas, gga)
+	}
+	assert.Equal(t, 1, len(ggas))
+	assert.Equal(t, 1, ggas[0].FixQuality)
+}
+
+func TestByTalker(t *testing.T) {
+	var texts []string
+	seq := nmea.ByTalker(nmea.Sentences(newIterTestParser(), strings.NewReader(iterTestData)), "GN")
+	for txt := range nmea.SkipErrors(nmea.OfType[*standard.TXT](seq)) {
+		texts = append(texts, txt.Text)
+	}
+	assert.Equal(t, []string{"hello"}, texts)
+}
+
+func TestByAddress(t *testing.T) {
+	var addresses []string
+	seq := nmea.ByAddress(nmea.Sentences(newIterTestParser(), strings.NewReader(iterTestData)), "GPGGA", "GPTXT")
+	for sentence := range nmea.SkipErrors(seq) {
+		addresses = append(addresses, sentence.GetAddress().String())
+	}
+	assert.Equal(t, []string{"GPGGA", "GPTXT"}, addresses)
+}
+
+func TestWithRaw(t *testing.T) {
+	var raws []string
+	for rawSentence, err := range nmea.WithRaw(newIterTestParser(), strings.NewReader(iterTestData)) {
+		if err != nil {
+			assert.Zero(t, rawSentence.Sentence)
+		}
+		raws = append(raws, string(rawSentence.Raw))
+	}
+	assert.Equal(t, []string{
+		"$GPGGA,092725.00,4717.11399,N,00833.91590,E,1,08,1.01,499.6,M,48.0,M,,*5B\r\n",
+		"$GNTXT,01,01,02,hello*31\r\n",
+		"$GPTXT,01,01,02,world*00\r\n",
+		"$GPTXT,01,01,02,world*2F\r\n",
+	}, raws)
+}
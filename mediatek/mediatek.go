@@ -0,0 +1,48 @@
+// Package mediatek parses and encodes MediaTek PMTK and Quectel PAIR and PQTM
+// NMEA sentences.
+//
+// Commands implement Command, which matches the receiver's response to the
+// command.
+//
+// See https://cdn-shop.adafruit.com/datasheets/PMTK_A11.pdf.
+package mediatek
+
+import (
+	"github.com/twpayne/go-nmea"
+)
+
+var sentenceParserMap = nmea.SentenceParserMap{
+	"PAIR001":     nmea.MakeSentenceParser(ParsePAIR001),
+	"PAIR050":     nmea.MakeSentenceParser(ParsePAIR050),
+	"PAIR062":     nmea.MakeSentenceParser(ParsePAIR062),
+	"PAIR066":     nmea.MakeSentenceParser(ParsePAIR066),
+	"PAIR864":     nmea.MakeSentenceParser(ParsePAIR864),
+	"PMTK001":     nmea.MakeSentenceParser(ParsePMTK001),
+	"PMTK220":     nmea.MakeSentenceParser(ParsePMTK220),
+	"PMTK251":     nmea.MakeSentenceParser(ParsePMTK251),
+	"PMTK314":     nmea.MakeSentenceParser(ParsePMTK314),
+	"PMTK353":     nmea.MakeSentenceParser(ParsePMTK353),
+	"PMTK605":     nmea.MakeSentenceParser(ParsePMTK605),
+	"PMTK705":     nmea.MakeSentenceParser(ParsePMTK705),
+	"PQTMSAVEPAR": nmea.MakeSentenceParser(ParsePQTMSAVEPAR),
+	"PQTMVERNO":   nmea.MakeSentenceParser(ParsePQTMVERNO),
+}
+
+// A Command is a command sent to the receiver.
+type Command interface {
+	nmea.SentenceEncoder
+	// IsResponse returns whether sentence is the receiver's final response
+	// to the command.
+	IsResponse(sentence nmea.Sentence) bool
+}
+
+func SentenceParserFunc(addr string) nmea.SentenceParser {
+	return sentenceParserMap[addr]
+}
+
+func boolByte(value bool) byte {
+	if value {
+		return '1'
+	}
+	return '0'
+}
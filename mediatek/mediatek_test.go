@@ -0,0 +1,250 @@
+package mediatek_test
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/mediatek"
+	"github.com/twpayne/go-nmea/nmeatest"
+)
+
+func TestSentenceParserFunc(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(mediatek.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$PAIR001,062,0*3F",
+				Expected: &mediatek.PAIR001{
+					Address: nmea.NewAddress("PAIR001"),
+					Command: 62,
+					Result:  mediatek.PAIRResultSucceeded,
+				},
+			},
+			{
+				S: "$PAIR050,1000*12",
+				Expected: &mediatek.PAIR050{
+					Address:     nmea.NewAddress("PAIR050"),
+					FixInterval: 1000,
+				},
+			},
+			{
+				S: "$PAIR062,0,1*3F",
+				Expected: &mediatek.PAIR062{
+					Address:    nmea.NewAddress("PAIR062"),
+					Type:       mediatek.PAIRSentenceGGA,
+					OutputRate: 1,
+				},
+			},
+			{
+				S: "$PAIR062,-1,0*12",
+				Expected: &mediatek.PAIR062{
+					Address: nmea.NewAddress("PAIR062"),
+					Type:    mediatek.PAIRSentenceAll,
+				},
+			},
+			{
+				S: "$PAIR066,1,1,1,1,0,0*3A",
+				Expected: &mediatek.PAIR066{
+					Address: nmea.NewAddress("PAIR066"),
+					GPS:     true,
+					GLONASS: true,
+					Galileo: true,
+					BeiDou:  true,
+				},
+			},
+			{
+				S: "$PAIR864,0,0,115200*1B",
+				Expected: &mediatek.PAIR864{
+					Address:  nmea.NewAddress("PAIR864"),
+					PortType: mediatek.PortTypeUART,
+					BaudRate: 115200,
+				},
+			},
+			{
+				S: "$PMTK001,314,3*36",
+				Expected: &mediatek.PMTK001{
+					Address: nmea.NewAddress("PMTK001"),
+					Command: 314,
+					Flag:    mediatek.AckFlagSucceeded,
+				},
+			},
+			{
+				S: "$PMTK220,100*2F",
+				Expected: &mediatek.PMTK220{
+					Address:     nmea.NewAddress("PMTK220"),
+					FixInterval: 100,
+				},
+			},
+			{
+				S: "$PMTK251,115200*1F",
+				Expected: &mediatek.PMTK251{
+					Address:  nmea.NewAddress("PMTK251"),
+					BaudRate: 115200,
+				},
+			},
+			{
+				S: "$PMTK314,0,1,0,1,1,5,0,0,0,0,0,0,0,0,0,0,0,0,0*2C",
+				Expected: &mediatek.PMTK314{
+					Address: nmea.NewAddress("PMTK314"),
+					Rates:   []int{0, 1, 0, 1, 1, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				},
+			},
+			{
+				S: "$PMTK314,-1*04",
+				Expected: &mediatek.PMTK314{
+					Address: nmea.NewAddress("PMTK314"),
+					Default: true,
+				},
+			},
+			{
+				S: "$PMTK353,1,1,0,0,0*2B",
+				Expected: &mediatek.PMTK353{
+					Address: nmea.NewAddress("PMTK353"),
+					Fields:  5,
+					GPS:     true,
+					GLONASS: true,
+				},
+			},
+			{
+				S: "$PMTK353,1,1*37",
+				Expected: &mediatek.PMTK353{
+					Address: nmea.NewAddress("PMTK353"),
+					Fields:  2,
+					GPS:     true,
+					GLONASS: true,
+				},
+			},
+			{
+				S: "$PMTK353,1,0,1*2B",
+				Expected: &mediatek.PMTK353{
+					Address: nmea.NewAddress("PMTK353"),
+					Fields:  3,
+					GPS:     true,
+					Galileo: true,
+				},
+			},
+			{
+				S: "$PMTK605*31",
+				Expected: &mediatek.PMTK605{
+					Address: nmea.NewAddress("PMTK605"),
+				},
+			},
+			{
+				S: "$PMTK705,AXN_5.1.7_3333_19020118,0027,PA1010D,1.0*76",
+				Expected: &mediatek.PMTK705{
+					Address:      nmea.NewAddress("PMTK705"),
+					Release:      "AXN_5.1.7_3333_19020118",
+					BuildID:      "0027",
+					ProductModel: "PA1010D",
+					SDKVersion:   nmea.NewOptional("1.0"),
+				},
+			},
+			{
+				S: "$PQTMSAVEPAR*5A",
+				Expected: &mediatek.PQTMSAVEPAR{
+					Address: nmea.NewAddress("PQTMSAVEPAR"),
+				},
+			},
+			{
+				S: "$PQTMSAVEPAR,OK*72",
+				Expected: &mediatek.PQTMSAVEPAR{
+					Address: nmea.NewAddress("PQTMSAVEPAR"),
+					Result:  mediatek.PQTMResultOK,
+				},
+			},
+			{
+				S: "$PQTMSAVEPAR,ERROR,1*33",
+				Expected: &mediatek.PQTMSAVEPAR{
+					Address:   nmea.NewAddress("PQTMSAVEPAR"),
+					Result:    mediatek.PQTMResultError,
+					ErrorCode: nmea.NewOptional(1),
+				},
+			},
+			{
+				S: "$PQTMVERNO*58",
+				Expected: &mediatek.PQTMVERNO{
+					Address: nmea.NewAddress("PQTMVERNO"),
+				},
+			},
+			{
+				S: "$PQTMVERNO,LC29HAANR11A03S,2021/12/02,17:30:39*3A",
+				Expected: &mediatek.PQTMVERNO{
+					Address:   nmea.NewAddress("PQTMVERNO"),
+					Version:   "LC29HAANR11A03S",
+					BuildDate: "2021/12/02",
+					BuildTime: "17:30:39",
+				},
+			},
+		})
+}
+
+func TestIsResponse(t *testing.T) {
+	parser := nmea.NewParser(
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+		nmea.WithSentenceParserFunc(mediatek.SentenceParserFunc),
+	)
+	for _, tc := range []struct {
+		name          string
+		command       mediatek.Command
+		response      string
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name:     "pmtk_ack",
+			command:  &mediatek.PMTK314{Address: nmea.NewAddress("PMTK314"), Default: true},
+			response: "$PMTK001,314,3*36",
+			expected: true,
+		},
+		{
+			name:     "pmtk_ack_other_command",
+			command:  &mediatek.PMTK220{Address: nmea.NewAddress("PMTK220"), FixInterval: 100},
+			response: "$PMTK001,314,3*36",
+		},
+		{
+			name:     "pmtk_firmware_query",
+			command:  &mediatek.PMTK605{Address: nmea.NewAddress("PMTK605")},
+			response: "$PMTK705,AXN_2.31_3339_13101700,5632,PA6H,1.0*6B",
+			expected: true,
+		},
+		{
+			name:     "pair_ack",
+			command:  &mediatek.PAIR062{Address: nmea.NewAddress("PAIR062"), OutputRate: 1},
+			response: "$PAIR001,062,0*3F",
+			expected: true,
+		},
+		{
+			name:          "pair_ack_processing",
+			command:       &mediatek.PAIR050{Address: nmea.NewAddress("PAIR050"), FixInterval: 1000},
+			response:      "$PAIR001,050,1*3F",
+			expectedError: true,
+		},
+		{
+			name:     "pqtm_query",
+			command:  &mediatek.PQTMSAVEPAR{Address: nmea.NewAddress("PQTMSAVEPAR")},
+			response: "$PQTMSAVEPAR*5A",
+		},
+		{
+			name:          "pqtm_error",
+			command:       &mediatek.PQTMSAVEPAR{Address: nmea.NewAddress("PQTMSAVEPAR")},
+			response:      "$PQTMSAVEPAR,ERROR,1*33",
+			expected:      true,
+			expectedError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := parser.ParseString(tc.response)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, tc.command.IsResponse(response))
+			if errer, ok := response.(interface{ Err() error }); ok {
+				assert.Equal(t, tc.expectedError, errer.Err() != nil)
+			}
+		})
+	}
+}
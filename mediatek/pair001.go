@@ -0,0 +1,82 @@
+package mediatek
+
+import (
+	"fmt"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// PAIR001 results.
+const (
+	PAIRResultSucceeded      = 0
+	PAIRResultProcessing     = 1
+	PAIRResultFailed         = 2
+	PAIRResultUnsupported    = 3
+	PAIRResultParameterError = 4
+	PAIRResultBusy           = 5
+)
+
+// A PAIRError is returned when the receiver does not acknowledge a PAIR
+// command as succeeded.
+type PAIRError struct {
+	Command int
+	Result  int
+}
+
+func (e *PAIRError) Error() string {
+	switch e.Result {
+	case PAIRResultProcessing:
+		return fmt.Sprintf("PAIR%03d: command processing", e.Command)
+	case PAIRResultFailed:
+		return fmt.Sprintf("PAIR%03d: command failed", e.Command)
+	case PAIRResultUnsupported:
+		return fmt.Sprintf("PAIR%03d: unsupported command", e.Command)
+	case PAIRResultParameterError:
+		return fmt.Sprintf("PAIR%03d: parameter error", e.Command)
+	case PAIRResultBusy:
+		return fmt.Sprintf("PAIR%03d: busy", e.Command)
+	default:
+		return fmt.Sprintf("PAIR%03d: %d: unknown result", e.Command, e.Result)
+	}
+}
+
+// A PAIR001 is an acknowledgement of a PAIR command.
+type PAIR001 struct {
+	nmea.Address
+	Command int
+	Result  int
+}
+
+func ParsePAIR001(addr string, tok *nmea.Tokenizer) (*PAIR001, error) {
+	var p PAIR001
+	p.Address = nmea.NewAddress(addr)
+	p.Command = tok.CommaUnsignedInt()
+	p.Result = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PAIR001) Encode(b *nmea.Builder) {
+	b.CommaZeroPaddedInt(p.Command, 3)
+	b.CommaInt(p.Result)
+}
+
+// Err returns a *PAIRError if p does not acknowledge the command as
+// succeeded.
+func (p *PAIR001) Err() error {
+	if p.Result == PAIRResultSucceeded {
+		return nil
+	}
+	return &PAIRError{
+		Command: p.Command,
+		Result:  p.Result,
+	}
+}
+
+// isPAIR001 returns whether sentence is a PAIR001 that is the final
+// acknowledgement of command. Acknowledgements that the command is still
+// being processed are not final.
+func isPAIR001(sentence nmea.Sentence, command int) bool {
+	ack, ok := sentence.(*PAIR001)
+	return ok && ack.Command == command && ack.Result != PAIRResultProcessing
+}
@@ -0,0 +1,25 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// A PAIR050 sets the position fix interval, in milliseconds.
+type PAIR050 struct {
+	nmea.Address
+	FixInterval int
+}
+
+func ParsePAIR050(addr string, tok *nmea.Tokenizer) (*PAIR050, error) {
+	var p PAIR050
+	p.Address = nmea.NewAddress(addr)
+	p.FixInterval = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PAIR050) Encode(b *nmea.Builder) {
+	b.CommaInt(p.FixInterval)
+}
+
+func (p *PAIR050) IsResponse(sentence nmea.Sentence) bool {
+	return isPAIR001(sentence, 50)
+}
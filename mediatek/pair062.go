@@ -0,0 +1,43 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// PAIR062 sentence types.
+const (
+	PAIRSentenceAll = -1
+	PAIRSentenceGGA = 0
+	PAIRSentenceGLL = 1
+	PAIRSentenceGSA = 2
+	PAIRSentenceGSV = 3
+	PAIRSentenceRMC = 4
+	PAIRSentenceVTG = 5
+	PAIRSentenceZDA = 6
+	PAIRSentenceGRS = 7
+	PAIRSentenceGST = 8
+)
+
+// A PAIR062 sets the output rate of a sentence, as a multiple of the fix
+// interval. A rate of zero disables the sentence.
+type PAIR062 struct {
+	nmea.Address
+	Type       int
+	OutputRate int
+}
+
+func ParsePAIR062(addr string, tok *nmea.Tokenizer) (*PAIR062, error) {
+	var p PAIR062
+	p.Address = nmea.NewAddress(addr)
+	p.Type = tok.CommaInt()
+	p.OutputRate = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PAIR062) Encode(b *nmea.Builder) {
+	b.CommaInt(p.Type)
+	b.CommaInt(p.OutputRate)
+}
+
+func (p *PAIR062) IsResponse(sentence nmea.Sentence) bool {
+	return isPAIR001(sentence, 62)
+}
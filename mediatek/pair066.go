@@ -0,0 +1,39 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// A PAIR066 sets the constellations that the receiver searches.
+type PAIR066 struct {
+	nmea.Address
+	GPS     bool
+	GLONASS bool
+	Galileo bool
+	BeiDou  bool
+	QZSS    bool
+}
+
+func ParsePAIR066(addr string, tok *nmea.Tokenizer) (*PAIR066, error) {
+	var p PAIR066
+	p.Address = nmea.NewAddress(addr)
+	p.GPS = tok.CommaOneByteOf("01") == '1'
+	p.GLONASS = tok.CommaOneByteOf("01") == '1'
+	p.Galileo = tok.CommaOneByteOf("01") == '1'
+	p.BeiDou = tok.CommaOneByteOf("01") == '1'
+	p.QZSS = tok.CommaOneByteOf("01") == '1'
+	tok.CommaLiteralByte('0') // Reserved.
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PAIR066) Encode(b *nmea.Builder) {
+	b.CommaByte(boolByte(p.GPS))
+	b.CommaByte(boolByte(p.GLONASS))
+	b.CommaByte(boolByte(p.Galileo))
+	b.CommaByte(boolByte(p.BeiDou))
+	b.CommaByte(boolByte(p.QZSS))
+	b.CommaByte('0')
+}
+
+func (p *PAIR066) IsResponse(sentence nmea.Sentence) bool {
+	return isPAIR001(sentence, 66)
+}
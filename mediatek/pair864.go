@@ -0,0 +1,36 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// PAIR864 port types.
+const (
+	PortTypeUART = 0
+)
+
+// A PAIR864 sets the baud rate of one of the receiver's ports.
+type PAIR864 struct {
+	nmea.Address
+	PortType  int
+	PortIndex int
+	BaudRate  int
+}
+
+func ParsePAIR864(addr string, tok *nmea.Tokenizer) (*PAIR864, error) {
+	var p PAIR864
+	p.Address = nmea.NewAddress(addr)
+	p.PortType = tok.CommaUnsignedInt()
+	p.PortIndex = tok.CommaUnsignedInt()
+	p.BaudRate = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PAIR864) Encode(b *nmea.Builder) {
+	b.CommaInt(p.PortType)
+	b.CommaInt(p.PortIndex)
+	b.CommaInt(p.BaudRate)
+}
+
+func (p *PAIR864) IsResponse(sentence nmea.Sentence) bool {
+	return isPAIR001(sentence, 864)
+}
@@ -0,0 +1,74 @@
+package mediatek
+
+import (
+	"fmt"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// PMTK001 flags.
+const (
+	AckFlagInvalidCommand     = 0
+	AckFlagUnsupportedCommand = 1
+	AckFlagFailed             = 2
+	AckFlagSucceeded          = 3
+)
+
+// An AckError is returned when the receiver does not acknowledge a command
+// as succeeded.
+type AckError struct {
+	Command int
+	Flag    int
+}
+
+func (e *AckError) Error() string {
+	switch e.Flag {
+	case AckFlagInvalidCommand:
+		return fmt.Sprintf("PMTK%03d: invalid command", e.Command)
+	case AckFlagUnsupportedCommand:
+		return fmt.Sprintf("PMTK%03d: unsupported command", e.Command)
+	case AckFlagFailed:
+		return fmt.Sprintf("PMTK%03d: command failed", e.Command)
+	default:
+		return fmt.Sprintf("PMTK%03d: %d: unknown flag", e.Command, e.Flag)
+	}
+}
+
+// A PMTK001 is an acknowledgement of a PMTK command.
+type PMTK001 struct {
+	nmea.Address
+	Command int
+	Flag    int
+}
+
+func ParsePMTK001(addr string, tok *nmea.Tokenizer) (*PMTK001, error) {
+	var p PMTK001
+	p.Address = nmea.NewAddress(addr)
+	p.Command = tok.CommaUnsignedInt()
+	p.Flag = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PMTK001) Encode(b *nmea.Builder) {
+	b.CommaZeroPaddedInt(p.Command, 3)
+	b.CommaInt(p.Flag)
+}
+
+// Err returns an *AckError if p does not acknowledge the command as
+// succeeded.
+func (p *PMTK001) Err() error {
+	if p.Flag == AckFlagSucceeded {
+		return nil
+	}
+	return &AckError{
+		Command: p.Command,
+		Flag:    p.Flag,
+	}
+}
+
+// isPMTK001 returns whether sentence is a PMTK001 that acknowledges command.
+func isPMTK001(sentence nmea.Sentence, command int) bool {
+	ack, ok := sentence.(*PMTK001)
+	return ok && ack.Command == command
+}
@@ -0,0 +1,25 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// A PMTK220 sets the position fix interval, in milliseconds.
+type PMTK220 struct {
+	nmea.Address
+	FixInterval int
+}
+
+func ParsePMTK220(addr string, tok *nmea.Tokenizer) (*PMTK220, error) {
+	var p PMTK220
+	p.Address = nmea.NewAddress(addr)
+	p.FixInterval = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PMTK220) Encode(b *nmea.Builder) {
+	b.CommaInt(p.FixInterval)
+}
+
+func (p *PMTK220) IsResponse(sentence nmea.Sentence) bool {
+	return isPMTK001(sentence, 220)
+}
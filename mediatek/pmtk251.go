@@ -0,0 +1,29 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// A PMTK251 sets the baud rate of the receiver's serial port. A baud rate of
+// zero restores the default.
+type PMTK251 struct {
+	nmea.Address
+	BaudRate int
+}
+
+func ParsePMTK251(addr string, tok *nmea.Tokenizer) (*PMTK251, error) {
+	var p PMTK251
+	p.Address = nmea.NewAddress(addr)
+	p.BaudRate = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PMTK251) Encode(b *nmea.Builder) {
+	b.CommaInt(p.BaudRate)
+}
+
+// IsResponse returns whether sentence acknowledges p. Receivers only
+// acknowledge PMTK251 at the new baud rate, and some do not acknowledge it
+// at all.
+func (p *PMTK251) IsResponse(sentence nmea.Sentence) bool {
+	return isPMTK001(sentence, 251)
+}
@@ -0,0 +1,56 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// Indexes of PMTK314 output rates.
+const (
+	OutputGLL  = 0
+	OutputRMC  = 1
+	OutputVTG  = 2
+	OutputGGA  = 3
+	OutputGSA  = 4
+	OutputGSV  = 5
+	OutputGRS  = 6
+	OutputGST  = 7
+	OutputZDA  = 17
+	OutputMCHN = 18
+)
+
+// A PMTK314 sets the output rate of each sentence, as a multiple of the fix
+// interval, indexed by the Output constants. A rate of zero disables the
+// sentence. If Default is set then Rates is ignored and the default output
+// rates are restored.
+type PMTK314 struct {
+	nmea.Address
+	Default bool
+	Rates   []int
+}
+
+func ParsePMTK314(addr string, tok *nmea.Tokenizer) (*PMTK314, error) {
+	var p PMTK314
+	p.Address = nmea.NewAddress(addr)
+	if rate := tok.CommaInt(); rate == -1 {
+		p.Default = true
+	} else {
+		p.Rates = append(p.Rates, rate)
+		for !tok.AtEndOfData() {
+			p.Rates = append(p.Rates, tok.CommaUnsignedInt())
+		}
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PMTK314) Encode(b *nmea.Builder) {
+	if p.Default {
+		b.CommaInt(-1)
+		return
+	}
+	for _, rate := range p.Rates {
+		b.CommaInt(rate)
+	}
+}
+
+func (p *PMTK314) IsResponse(sentence nmea.Sentence) bool {
+	return isPMTK001(sentence, 314)
+}
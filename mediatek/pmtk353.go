@@ -0,0 +1,56 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// A PMTK353 sets the constellations that the receiver searches. Older
+// firmware only supports the GPS and GLONASS fields, or the GPS, GLONASS,
+// and Galileo fields.
+//
+// Fields is the number of fields: 2, 3, or 5. Zero means 5. Encode only
+// writes the first Fields fields.
+type PMTK353 struct {
+	nmea.Address
+	Fields      int
+	GPS         bool
+	GLONASS     bool
+	Galileo     bool
+	GalileoFull bool
+	BeiDou      bool
+}
+
+func ParsePMTK353(addr string, tok *nmea.Tokenizer) (*PMTK353, error) {
+	var p PMTK353
+	p.Address = nmea.NewAddress(addr)
+	p.Fields = 2
+	p.GPS = tok.CommaOneByteOf("01") == '1'
+	p.GLONASS = tok.CommaOneByteOf("01") == '1'
+	if !tok.AtEndOfData() {
+		p.Fields = 3
+		p.Galileo = tok.CommaOneByteOf("01") == '1'
+	}
+	if !tok.AtEndOfData() {
+		p.Fields = 5
+		p.GalileoFull = tok.CommaOneByteOf("01") == '1'
+		p.BeiDou = tok.CommaOneByteOf("01") == '1'
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PMTK353) Encode(b *nmea.Builder) {
+	b.CommaByte(boolByte(p.GPS))
+	b.CommaByte(boolByte(p.GLONASS))
+	if p.Fields == 2 {
+		return
+	}
+	b.CommaByte(boolByte(p.Galileo))
+	if p.Fields == 3 {
+		return
+	}
+	b.CommaByte(boolByte(p.GalileoFull))
+	b.CommaByte(boolByte(p.BeiDou))
+}
+
+func (p *PMTK353) IsResponse(sentence nmea.Sentence) bool {
+	return isPMTK001(sentence, 353)
+}
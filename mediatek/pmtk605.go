@@ -0,0 +1,23 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// A PMTK605 queries the receiver's firmware release. The receiver responds
+// with a PMTK705.
+type PMTK605 struct {
+	nmea.Address
+}
+
+func ParsePMTK605(addr string, tok *nmea.Tokenizer) (*PMTK605, error) {
+	var p PMTK605
+	p.Address = nmea.NewAddress(addr)
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PMTK605) Encode(b *nmea.Builder) {}
+
+func (p *PMTK605) IsResponse(sentence nmea.Sentence) bool {
+	_, ok := sentence.(*PMTK705)
+	return ok
+}
@@ -0,0 +1,34 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// A PMTK705 is the receiver's firmware release, in response to a PMTK605.
+type PMTK705 struct {
+	nmea.Address
+	Release      string
+	BuildID      string
+	ProductModel string
+	SDKVersion   nmea.Optional[string]
+}
+
+func ParsePMTK705(addr string, tok *nmea.Tokenizer) (*PMTK705, error) {
+	var p PMTK705
+	p.Address = nmea.NewAddress(addr)
+	p.Release = tok.CommaString()
+	p.BuildID = tok.CommaString()
+	p.ProductModel = tok.CommaString()
+	if !tok.AtEndOfData() {
+		p.SDKVersion = tok.CommaOptionalString()
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PMTK705) Encode(b *nmea.Builder) {
+	b.CommaString(p.Release)
+	b.CommaString(p.BuildID)
+	b.CommaString(p.ProductModel)
+	if p.SDKVersion.Valid {
+		b.CommaString(p.SDKVersion.Value)
+	}
+}
@@ -0,0 +1,71 @@
+package mediatek
+
+import (
+	"fmt"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// PQTM results.
+const (
+	PQTMResultOK    = "OK"
+	PQTMResultError = "ERROR"
+)
+
+// A PQTMError is returned when the receiver responds to a PQTM command with
+// an error.
+type PQTMError struct {
+	Command   string
+	ErrorCode int
+}
+
+func (e *PQTMError) Error() string {
+	return fmt.Sprintf("%s: error code %d", e.Command, e.ErrorCode)
+}
+
+// A PQTMSAVEPAR saves the receiver's configuration to non-volatile memory.
+// The command has no result and the receiver's response has a result.
+type PQTMSAVEPAR struct {
+	nmea.Address
+	Result    string
+	ErrorCode nmea.Optional[int]
+}
+
+func ParsePQTMSAVEPAR(addr string, tok *nmea.Tokenizer) (*PQTMSAVEPAR, error) {
+	var p PQTMSAVEPAR
+	p.Address = nmea.NewAddress(addr)
+	if !tok.AtEndOfData() {
+		p.Result = tok.CommaString()
+		if p.Result == PQTMResultError {
+			p.ErrorCode = nmea.NewOptional(tok.CommaUnsignedInt())
+		}
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PQTMSAVEPAR) Encode(b *nmea.Builder) {
+	if p.Result == "" {
+		return
+	}
+	b.CommaString(p.Result)
+	if p.ErrorCode.Valid {
+		b.CommaInt(p.ErrorCode.Value)
+	}
+}
+
+// Err returns a *PQTMError if p is a response with an error.
+func (p *PQTMSAVEPAR) Err() error {
+	if p.Result != PQTMResultError {
+		return nil
+	}
+	return &PQTMError{
+		Command:   p.String(),
+		ErrorCode: p.ErrorCode.Value,
+	}
+}
+
+func (p *PQTMSAVEPAR) IsResponse(sentence nmea.Sentence) bool {
+	response, ok := sentence.(*PQTMSAVEPAR)
+	return ok && response.Result != ""
+}
@@ -0,0 +1,39 @@
+package mediatek
+
+import "github.com/twpayne/go-nmea"
+
+// A PQTMVERNO queries the receiver's firmware version. The query has no
+// fields and the receiver's response has the version, build date, and
+// build time.
+type PQTMVERNO struct {
+	nmea.Address
+	Version   string
+	BuildDate string
+	BuildTime string
+}
+
+func ParsePQTMVERNO(addr string, tok *nmea.Tokenizer) (*PQTMVERNO, error) {
+	var p PQTMVERNO
+	p.Address = nmea.NewAddress(addr)
+	if !tok.AtEndOfData() {
+		p.Version = tok.CommaString()
+		p.BuildDate = tok.CommaString()
+		p.BuildTime = tok.CommaString()
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PQTMVERNO) Encode(b *nmea.Builder) {
+	if p.Version == "" {
+		return
+	}
+	b.CommaString(p.Version)
+	b.CommaString(p.BuildDate)
+	b.CommaString(p.BuildTime)
+}
+
+func (p *PQTMVERNO) IsResponse(sentence nmea.Sentence) bool {
+	response, ok := sentence.(*PQTMVERNO)
+	return ok && response.Version != ""
+}
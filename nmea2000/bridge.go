@@ -0,0 +1,458 @@
+package nmea2000
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+const (
+	metersPerNauticalMile = 1852
+	metersPerStatuteMile  = 1609.344
+)
+
+// A Bridge converts between NMEA 2000 messages and NMEA 0183 sentences.
+//
+// NMEA 0183 sentences that only carry a time of day are combined with the
+// most recent date seen in either direction.
+type Bridge struct {
+	talker     string
+	source     int
+	sequenceID int
+	time       nmea.Optional[time.Time]
+}
+
+type BridgeOption func(*Bridge)
+
+// WithTalker sets the talker of the NMEA 0183 sentences generated by the
+// bridge. The default is II, integrated instrumentation.
+func WithTalker(talker string) BridgeOption {
+	return func(b *Bridge) {
+		b.talker = talker
+	}
+}
+
+// WithSource sets the source address of the NMEA 2000 messages generated by
+// the bridge.
+func WithSource(source int) BridgeOption {
+	return func(b *Bridge) {
+		b.source = source
+	}
+}
+
+func NewBridge(options ...BridgeOption) *Bridge {
+	b := &Bridge{
+		talker: "II",
+	}
+	for _, option := range options {
+		option(b)
+	}
+	return b
+}
+
+// Sentences returns the NMEA 0183 sentences equivalent to m. It returns no
+// sentences if m's PGN is not supported or m does not contain enough data.
+func (b *Bridge) Sentences(m *Message) ([]nmea.Sentence, error) {
+	if payloadDecoderMap[m.PGN] == nil {
+		return nil, nil
+	}
+	payload, err := m.Decode()
+	if err != nil {
+		return nil, err
+	}
+	switch payload := payload.(type) {
+	case *SystemTime:
+		if !payload.Time.Valid {
+			return nil, nil
+		}
+		b.time = payload.Time
+		return []nmea.Sentence{
+			&standard.ZDA{
+				Address: b.address("ZDA"),
+				Time:    payload.Time.Value,
+			},
+		}, nil
+	case *VesselHeading:
+		heading, ok := trueHeading(payload)
+		if !ok {
+			return nil, nil
+		}
+		return []nmea.Sentence{
+			&standard.HDT{
+				Address:     b.address("HDT"),
+				HeadingTrue: heading,
+			},
+		}, nil
+	case *WaterDepth:
+		if !payload.Depth.Valid {
+			return nil, nil
+		}
+		return []nmea.Sentence{
+			&standard.DPT{
+				Address: b.address("DPT"),
+				Depth:   payload.Depth.Value,
+				Offset:  payload.Offset,
+				Maximum: payload.Range,
+			},
+		}, nil
+	case *PositionRapidUpdate:
+		if !payload.Lat.Valid || !payload.Lon.Valid || !b.time.Valid {
+			return nil, nil
+		}
+		return []nmea.Sentence{
+			&standard.GLL{
+				Address:   b.address("GLL"),
+				Lat:       payload.Lat,
+				Lon:       payload.Lon,
+				TimeOfDay: timeOfDay(b.time.Value),
+				Status:    'A',
+				PosMode:   'A',
+			},
+		}, nil
+	case *COGSOGRapidUpdate:
+		vtg := &standard.VTG{
+			Address:            b.address("VTG"),
+			SpeedOverGroundKN:  mapOptional(payload.SpeedOverGroundMPS, metersPerSecondToKnots),
+			SpeedOverGroundKPH: mapOptional(payload.SpeedOverGroundMPS, metersPerSecondToKPH),
+			ModeIndicator:      'A',
+		}
+		switch payload.CourseOverGroundRef {
+		case HeadingReferenceTrue:
+			vtg.CourseOverGroundTrue = payload.CourseOverGround
+		case HeadingReferenceMagnetic:
+			vtg.CourseOverGroundMagnetic = payload.CourseOverGround
+		}
+		return []nmea.Sentence{vtg}, nil
+	case *GNSSPositionData:
+		if payload.Time.Valid {
+			b.time = payload.Time
+		}
+		gga := &standard.GGA{
+			Address:                          b.address("GGA"),
+			Lat:                              payload.Lat,
+			Lon:                              payload.Lon,
+			FixQuality:                       int(payload.Method),
+			NumberOfSatellites:               payload.NumberOfSVs,
+			HDOP:                             payload.HDOP,
+			HeightOfGeoidAboveWGS84Ellipsoid: payload.GeoidalSeparation,
+		}
+		if payload.Method == GNSSMethodNotAvailable {
+			gga.FixQuality = int(GNSSMethodNoGNSS)
+		}
+		if payload.Time.Valid {
+			gga.TimeOfDay = nmea.NewOptional(timeOfDay(payload.Time.Value))
+		}
+		if payload.Alt.Valid {
+			alt := payload.Alt.Value
+			if payload.GeoidalSeparation.Valid {
+				alt -= payload.GeoidalSeparation.Value
+			}
+			gga.Alt = nmea.NewOptional(alt)
+		}
+		if len(payload.ReferenceStations) > 0 {
+			gga.TimeSinceLastDGPSUpdate = payload.ReferenceStations[0].AgeOfCorrection
+			gga.DGPSReferenceStationID = fmt.Sprintf("%04d", payload.ReferenceStations[0].ID)
+		}
+		return []nmea.Sentence{gga}, nil
+	case *WindData:
+		var reference byte
+		switch payload.Reference {
+		case WindReferenceApparent:
+			reference = 'R'
+		case WindReferenceTrueBoat, WindReferenceTrueWater:
+			reference = 'T'
+		default:
+			return nil, nil
+		}
+		status := byte('A')
+		if !payload.WindAngle.Valid || !payload.WindSpeedMPS.Valid {
+			status = 'V'
+		}
+		return []nmea.Sentence{
+			&standard.MWV{
+				Address:       b.address("MWV"),
+				WindAngle:     payload.WindAngle,
+				Reference:     reference,
+				WindSpeed:     payload.WindSpeedMPS,
+				WindSpeedUnit: 'M',
+				Status:        status,
+			},
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// Messages returns the NMEA 2000 messages equivalent to s. It returns no
+// messages if s is not supported or does not contain valid data.
+func (b *Bridge) Messages(s nmea.Sentence) ([]*Message, error) {
+	var payloads []Payload
+	switch s := s.(type) {
+	case *standard.DBT:
+		payloads = append(payloads, &WaterDepth{
+			SequenceID: b.nextSequenceID(),
+			Depth:      nmea.NewOptional(s.Depth),
+		})
+	case *standard.DPT:
+		payloads = append(payloads, &WaterDepth{
+			SequenceID: b.nextSequenceID(),
+			Depth:      nmea.NewOptional(s.Depth),
+			Offset:     s.Offset,
+			Range:      s.Maximum,
+		})
+	case *standard.GGA:
+		gpd := &GNSSPositionData{
+			SequenceID:        b.nextSequenceID(),
+			Lat:               s.Lat,
+			Lon:               s.Lon,
+			GNSSType:          gnssType(s.Talker()),
+			Method:            GNSSMethod(s.FixQuality),
+			NumberOfSVs:       s.NumberOfSatellites,
+			HDOP:              s.HDOP,
+			GeoidalSeparation: s.HeightOfGeoidAboveWGS84Ellipsoid,
+		}
+		if s.TimeOfDay.Valid {
+			gpd.Time = b.combine(s.TimeOfDay.Value)
+		}
+		if s.Alt.Valid {
+			alt := s.Alt.Value
+			if s.HeightOfGeoidAboveWGS84Ellipsoid.Valid {
+				alt += s.HeightOfGeoidAboveWGS84Ellipsoid.Value
+			}
+			gpd.Alt = nmea.NewOptional(alt)
+		}
+		if s.DGPSReferenceStationID != "" || s.TimeSinceLastDGPSUpdate.Valid {
+			id, _ := strconv.Atoi(s.DGPSReferenceStationID)
+			gpd.ReferenceStations = []ReferenceStation{
+				{
+					ID:              id,
+					AgeOfCorrection: s.TimeSinceLastDGPSUpdate,
+				},
+			}
+		}
+		payloads = append(payloads, gpd)
+	case *standard.GLL:
+		if s.Status != 'A' {
+			return nil, nil
+		}
+		b.combine(s.TimeOfDay)
+		payloads = append(payloads, &PositionRapidUpdate{
+			Lat: s.Lat,
+			Lon: s.Lon,
+		})
+	case *standard.HDT:
+		payloads = append(payloads, &VesselHeading{
+			SequenceID: b.nextSequenceID(),
+			Heading:    nmea.NewOptional(s.HeadingTrue),
+			Reference:  HeadingReferenceTrue,
+		})
+	case *standard.MWV:
+		if s.Status != 'A' {
+			return nil, nil
+		}
+		reference := WindReferenceApparent
+		if s.Reference == 'T' {
+			reference = WindReferenceTrueBoat
+		}
+		payloads = append(payloads, &WindData{
+			SequenceID:   b.nextSequenceID(),
+			WindSpeedMPS: mapOptional(s.WindSpeed, windSpeedToMetersPerSecond(s.WindSpeedUnit)),
+			WindAngle:    s.WindAngle,
+			Reference:    reference,
+		})
+	case *standard.RMC:
+		if s.Status != 'A' {
+			return nil, nil
+		}
+		sequenceID := b.nextSequenceID()
+		if t := s.Time(); t.Valid {
+			b.time = t
+			payloads = append(payloads, &SystemTime{
+				SequenceID: sequenceID,
+				Source:     TimeSourceGPS,
+				Time:       t,
+			})
+		}
+		payloads = append(payloads,
+			&PositionRapidUpdate{
+				Lat: s.Lat,
+				Lon: s.Lon,
+			},
+			&COGSOGRapidUpdate{
+				SequenceID:          sequenceID,
+				CourseOverGroundRef: HeadingReferenceTrue,
+				CourseOverGround:    s.CourseOverGround,
+				SpeedOverGroundMPS:  mapOptional(s.SpeedOverGroundKN, knotsToMetersPerSecond),
+			},
+		)
+	case *standard.THS:
+		if s.ModeIndicator == 'V' {
+			return nil, nil
+		}
+		payloads = append(payloads, &VesselHeading{
+			SequenceID: b.nextSequenceID(),
+			Heading:    nmea.NewOptional(s.HeadingTrue),
+			Reference:  HeadingReferenceTrue,
+		})
+	case *standard.VTG:
+		cs := &COGSOGRapidUpdate{
+			SequenceID: b.nextSequenceID(),
+		}
+		switch {
+		case s.CourseOverGroundTrue.Valid:
+			cs.CourseOverGroundRef = HeadingReferenceTrue
+			cs.CourseOverGround = s.CourseOverGroundTrue
+		case s.CourseOverGroundMagnetic.Valid:
+			cs.CourseOverGroundRef = HeadingReferenceMagnetic
+			cs.CourseOverGround = s.CourseOverGroundMagnetic
+		default:
+			cs.CourseOverGroundRef = HeadingReferenceNotAvailable
+		}
+		switch {
+		case s.SpeedOverGroundKN.Valid:
+			cs.SpeedOverGroundMPS = mapOptional(s.SpeedOverGroundKN, knotsToMetersPerSecond)
+		case s.SpeedOverGroundKPH.Valid:
+			cs.SpeedOverGroundMPS = mapOptional(s.SpeedOverGroundKPH, kphToMetersPerSecond)
+		}
+		payloads = append(payloads, cs)
+	case *standard.ZDA:
+		b.time = nmea.NewOptional(s.Time)
+		payloads = append(payloads, &SystemTime{
+			SequenceID: b.nextSequenceID(),
+			Source:     TimeSourceGPS,
+			Time:       b.time,
+		})
+	default:
+		return nil, nil
+	}
+	messages := make([]*Message, 0, len(payloads))
+	for _, payload := range payloads {
+		message := NewMessage(priority(payload.PGN()), payload)
+		message.Source = b.source
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func (b *Bridge) address(formatter string) nmea.Address {
+	return nmea.NewAddress(b.talker + formatter)
+}
+
+// combine returns the time at timeOfDay on the most recently seen date,
+// advancing the date if timeOfDay is more than twelve hours before the most
+// recently seen time, and records the result as the most recently seen time.
+func (b *Bridge) combine(timeOfDay nmea.TimeOfDay) nmea.Optional[time.Time] {
+	if !b.time.Valid {
+		return nmea.Optional[time.Time]{}
+	}
+	year, month, day := b.time.Value.Date()
+	t := nmea.Date{Year: year, Month: month, Day: day}.At(timeOfDay)
+	if t.Before(b.time.Value.Add(-12 * time.Hour)) {
+		t = t.AddDate(0, 0, 1)
+	}
+	b.time = nmea.NewOptional(t)
+	return b.time
+}
+
+func (b *Bridge) nextSequenceID() nmea.Optional[int] {
+	sequenceID := b.sequenceID
+	b.sequenceID = (b.sequenceID + 1) % (maxSequenceID + 1)
+	return nmea.NewOptional(sequenceID)
+}
+
+func gnssType(talker string) GNSSType {
+	switch talker {
+	case "GA":
+		return GNSSTypeGalileo
+	case "GL":
+		return GNSSTypeGLONASS
+	case "GN":
+		return GNSSTypeGPSGLONASS
+	default:
+		return GNSSTypeGPS
+	}
+}
+
+func priority(pgn PGN) int {
+	switch pgn {
+	case PGNPositionRapidUpdate, PGNCOGSOGRapidUpdate, PGNVesselHeading, PGNWindData:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func trueHeading(vh *VesselHeading) (float64, bool) {
+	if !vh.Heading.Valid {
+		return 0, false
+	}
+	switch vh.Reference {
+	case HeadingReferenceTrue:
+		return vh.Heading.Value, true
+	case HeadingReferenceMagnetic:
+		if !vh.Variation.Valid {
+			return 0, false
+		}
+		heading := vh.Heading.Value + vh.Variation.Value
+		if vh.Deviation.Valid {
+			heading += vh.Deviation.Value
+		}
+		return math.Mod(heading+360, 360), true
+	default:
+		return 0, false
+	}
+}
+
+func timeOfDay(t time.Time) nmea.TimeOfDay {
+	t = t.UTC()
+	return nmea.TimeOfDay{
+		Hour:       t.Hour(),
+		Minute:     t.Minute(),
+		Second:     t.Second(),
+		Nanosecond: t.Nanosecond(),
+	}
+}
+
+func mapOptional(value nmea.Optional[float64], f func(float64) float64) nmea.Optional[float64] {
+	if !value.Valid {
+		return value
+	}
+	return nmea.NewOptional(f(value.Value))
+}
+
+func knotsToMetersPerSecond(kn float64) float64 {
+	return kn * metersPerNauticalMile / 3600
+}
+
+func kphToMetersPerSecond(kph float64) float64 {
+	return kph / 3.6
+}
+
+func metersPerSecondToKnots(mps float64) float64 {
+	return mps * 3600 / metersPerNauticalMile
+}
+
+func metersPerSecondToKPH(mps float64) float64 {
+	return mps * 3.6
+}
+
+func windSpeedToMetersPerSecond(unit byte) func(float64) float64 {
+	switch unit {
+	case 'K':
+		return kphToMetersPerSecond
+	case 'N':
+		return knotsToMetersPerSecond
+	case 'S':
+		return func(mph float64) float64 {
+			return mph * metersPerStatuteMile / 3600
+		}
+	default:
+		return func(mps float64) float64 {
+			return mps
+		}
+	}
+}
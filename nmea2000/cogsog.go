@@ -0,0 +1,38 @@
+package nmea2000
+
+import "github.com/twpayne/go-nmea"
+
+type COGSOGRapidUpdate struct {
+	SequenceID          nmea.Optional[int]
+	CourseOverGroundRef HeadingReference
+	CourseOverGround    nmea.Optional[float64]
+	SpeedOverGroundMPS  nmea.Optional[float64]
+}
+
+func DecodeCOGSOGRapidUpdate(data []byte) (*COGSOGRapidUpdate, error) {
+	var cs COGSOGRapidUpdate
+	d := newFieldDecoder(data)
+	cs.SequenceID = d.OptionalUint8()
+	cs.CourseOverGroundRef = HeadingReference(d.Uint8() & headingReferenceMask)
+	cs.CourseOverGround = d.OptionalAngleUint16()
+	cs.SpeedOverGroundMPS = d.OptionalScaledUint16(speedResolution)
+	d.Skip(2)
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	return &cs, nil
+}
+
+func (cs *COGSOGRapidUpdate) PGN() PGN {
+	return PGNCOGSOGRapidUpdate
+}
+
+func (cs *COGSOGRapidUpdate) AppendData(data []byte) []byte {
+	e := fieldEncoder{data: data}
+	e.OptionalUint8(cs.SequenceID)
+	e.Uint8(0xfc | uint8(cs.CourseOverGroundRef)&headingReferenceMask)
+	e.OptionalAngleUint16(cs.CourseOverGround)
+	e.OptionalScaledUint16(cs.SpeedOverGroundMPS, speedResolution)
+	e.Reserved(2)
+	return e.data
+}
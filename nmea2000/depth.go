@@ -0,0 +1,42 @@
+package nmea2000
+
+import "github.com/twpayne/go-nmea"
+
+const (
+	depthResolution  = 1e-2
+	offsetResolution = 1e-3
+	rangeResolution  = 10
+)
+
+type WaterDepth struct {
+	SequenceID nmea.Optional[int]
+	Depth      nmea.Optional[float64]
+	Offset     nmea.Optional[float64]
+	Range      nmea.Optional[float64]
+}
+
+func DecodeWaterDepth(data []byte) (*WaterDepth, error) {
+	var wd WaterDepth
+	d := newFieldDecoder(data)
+	wd.SequenceID = d.OptionalUint8()
+	wd.Depth = d.OptionalScaledUint32(depthResolution)
+	wd.Offset = d.OptionalScaledInt16(offsetResolution)
+	wd.Range = scale(d.OptionalUint8(), rangeResolution)
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	return &wd, nil
+}
+
+func (wd *WaterDepth) PGN() PGN {
+	return PGNWaterDepth
+}
+
+func (wd *WaterDepth) AppendData(data []byte) []byte {
+	e := fieldEncoder{data: data}
+	e.OptionalUint8(wd.SequenceID)
+	e.OptionalScaledUint32(wd.Depth, depthResolution)
+	e.OptionalScaledInt16(wd.Offset, offsetResolution)
+	e.OptionalUint8(unscale[int](wd.Range, rangeResolution))
+	return e.data
+}
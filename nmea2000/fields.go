@@ -0,0 +1,278 @@
+package nmea2000
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// Resolutions of the fields used by the supported PGNs.
+const (
+	angleResolution    = 1e-4
+	latLonResolution   = 1e-7
+	latLon64Resolution = 1e-16
+	speedResolution    = 1e-2
+)
+
+// A fieldDecoder decodes little-endian fields from PGN data. Unsigned fields
+// whose bits are all set and signed fields that equal their maximum value
+// indicate that the data is not available.
+type fieldDecoder struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func newFieldDecoder(data []byte) *fieldDecoder {
+	return &fieldDecoder{
+		data: data,
+	}
+}
+
+func (d *fieldDecoder) Err() error {
+	return d.err
+}
+
+func (d *fieldDecoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if d.pos+n > len(d.data) {
+		d.err = errShortData
+		return nil
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b
+}
+
+func (d *fieldDecoder) Uint8() uint8 {
+	b := d.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (d *fieldDecoder) Skip(n int) {
+	d.next(n)
+}
+
+func (d *fieldDecoder) OptionalUint8() nmea.Optional[int] {
+	if value := d.Uint8(); d.err == nil && value != math.MaxUint8 {
+		return nmea.NewOptional(int(value))
+	}
+	return nmea.Optional[int]{}
+}
+
+func (d *fieldDecoder) OptionalUint16() nmea.Optional[int] {
+	b := d.next(2)
+	if b == nil {
+		return nmea.Optional[int]{}
+	}
+	if value := binary.LittleEndian.Uint16(b); value != math.MaxUint16 {
+		return nmea.NewOptional(int(value))
+	}
+	return nmea.Optional[int]{}
+}
+
+func (d *fieldDecoder) OptionalUint32() nmea.Optional[int] {
+	b := d.next(4)
+	if b == nil {
+		return nmea.Optional[int]{}
+	}
+	if value := binary.LittleEndian.Uint32(b); value != math.MaxUint32 {
+		return nmea.NewOptional(int(value))
+	}
+	return nmea.Optional[int]{}
+}
+
+func (d *fieldDecoder) OptionalInt16() nmea.Optional[int] {
+	b := d.next(2)
+	if b == nil {
+		return nmea.Optional[int]{}
+	}
+	if value := int16(binary.LittleEndian.Uint16(b)); value != math.MaxInt16 {
+		return nmea.NewOptional(int(value))
+	}
+	return nmea.Optional[int]{}
+}
+
+func (d *fieldDecoder) OptionalInt32() nmea.Optional[int] {
+	b := d.next(4)
+	if b == nil {
+		return nmea.Optional[int]{}
+	}
+	if value := int32(binary.LittleEndian.Uint32(b)); value != math.MaxInt32 {
+		return nmea.NewOptional(int(value))
+	}
+	return nmea.Optional[int]{}
+}
+
+func (d *fieldDecoder) OptionalInt64() nmea.Optional[int64] {
+	b := d.next(8)
+	if b == nil {
+		return nmea.Optional[int64]{}
+	}
+	if value := int64(binary.LittleEndian.Uint64(b)); value != math.MaxInt64 {
+		return nmea.NewOptional(value)
+	}
+	return nmea.Optional[int64]{}
+}
+
+func (d *fieldDecoder) OptionalScaledUint16(resolution float64) nmea.Optional[float64] {
+	return scale(d.OptionalUint16(), resolution)
+}
+
+func (d *fieldDecoder) OptionalScaledUint32(resolution float64) nmea.Optional[float64] {
+	return scale(d.OptionalUint32(), resolution)
+}
+
+func (d *fieldDecoder) OptionalScaledInt16(resolution float64) nmea.Optional[float64] {
+	return scale(d.OptionalInt16(), resolution)
+}
+
+func (d *fieldDecoder) OptionalScaledInt32(resolution float64) nmea.Optional[float64] {
+	return scale(d.OptionalInt32(), resolution)
+}
+
+func (d *fieldDecoder) OptionalScaledInt64(resolution float64) nmea.Optional[float64] {
+	return scale(d.OptionalInt64(), resolution)
+}
+
+func (d *fieldDecoder) OptionalAngleUint16() nmea.Optional[float64] {
+	return radiansToDegrees(d.OptionalScaledUint16(angleResolution))
+}
+
+func (d *fieldDecoder) OptionalAngleInt16() nmea.Optional[float64] {
+	return radiansToDegrees(d.OptionalScaledInt16(angleResolution))
+}
+
+// A fieldEncoder appends little-endian fields to PGN data, encoding
+// unavailable values with the corresponding not available value.
+type fieldEncoder struct {
+	data []byte
+}
+
+func (e *fieldEncoder) Uint8(value uint8) {
+	e.data = append(e.data, value)
+}
+
+func (e *fieldEncoder) Reserved(n int) {
+	for i := 0; i < n; i++ {
+		e.data = append(e.data, 0xff)
+	}
+}
+
+func (e *fieldEncoder) OptionalUint8(value nmea.Optional[int]) {
+	if !value.Valid {
+		e.Uint8(math.MaxUint8)
+		return
+	}
+	e.Uint8(uint8(value.Value))
+}
+
+func (e *fieldEncoder) OptionalUint16(value nmea.Optional[int]) {
+	if !value.Valid {
+		e.data = binary.LittleEndian.AppendUint16(e.data, math.MaxUint16)
+		return
+	}
+	e.data = binary.LittleEndian.AppendUint16(e.data, uint16(value.Value))
+}
+
+func (e *fieldEncoder) OptionalUint32(value nmea.Optional[int]) {
+	if !value.Valid {
+		e.data = binary.LittleEndian.AppendUint32(e.data, math.MaxUint32)
+		return
+	}
+	e.data = binary.LittleEndian.AppendUint32(e.data, uint32(value.Value))
+}
+
+func (e *fieldEncoder) OptionalInt16(value nmea.Optional[int]) {
+	if !value.Valid {
+		e.data = binary.LittleEndian.AppendUint16(e.data, math.MaxInt16)
+		return
+	}
+	e.data = binary.LittleEndian.AppendUint16(e.data, uint16(int16(value.Value)))
+}
+
+func (e *fieldEncoder) OptionalInt32(value nmea.Optional[int]) {
+	if !value.Valid {
+		e.data = binary.LittleEndian.AppendUint32(e.data, math.MaxInt32)
+		return
+	}
+	e.data = binary.LittleEndian.AppendUint32(e.data, uint32(int32(value.Value)))
+}
+
+func (e *fieldEncoder) OptionalInt64(value nmea.Optional[int64]) {
+	if !value.Valid {
+		e.data = binary.LittleEndian.AppendUint64(e.data, math.MaxInt64)
+		return
+	}
+	e.data = binary.LittleEndian.AppendUint64(e.data, uint64(value.Value))
+}
+
+func (e *fieldEncoder) OptionalScaledUint16(value nmea.Optional[float64], resolution float64) {
+	e.OptionalUint16(unscale[int](value, resolution))
+}
+
+func (e *fieldEncoder) OptionalScaledUint32(value nmea.Optional[float64], resolution float64) {
+	e.OptionalUint32(unscale[int](value, resolution))
+}
+
+func (e *fieldEncoder) OptionalScaledInt16(value nmea.Optional[float64], resolution float64) {
+	e.OptionalInt16(unscale[int](value, resolution))
+}
+
+func (e *fieldEncoder) OptionalScaledInt32(value nmea.Optional[float64], resolution float64) {
+	e.OptionalInt32(unscale[int](value, resolution))
+}
+
+func (e *fieldEncoder) OptionalScaledInt64(value nmea.Optional[float64], resolution float64) {
+	e.OptionalInt64(unscale[int64](value, resolution))
+}
+
+func (e *fieldEncoder) OptionalAngleUint16(value nmea.Optional[float64]) {
+	e.OptionalScaledUint16(degreesToRadians(value), angleResolution)
+}
+
+func (e *fieldEncoder) OptionalAngleInt16(value nmea.Optional[float64]) {
+	e.OptionalScaledInt16(degreesToRadians(value), angleResolution)
+}
+
+// scale and unscale divide and multiply by the reciprocal of resolution where
+// possible so that exact decimal values survive the round trip.
+func scale[T int | int64](value nmea.Optional[T], resolution float64) nmea.Optional[float64] {
+	if !value.Valid {
+		return nmea.Optional[float64]{}
+	}
+	if resolution < 1 {
+		return nmea.NewOptional(float64(value.Value) / math.Round(1/resolution))
+	}
+	return nmea.NewOptional(float64(value.Value) * resolution)
+}
+
+func unscale[T int | int64](value nmea.Optional[float64], resolution float64) nmea.Optional[T] {
+	if !value.Valid {
+		return nmea.Optional[T]{}
+	}
+	if resolution < 1 {
+		return nmea.NewOptional(T(math.Round(value.Value * math.Round(1/resolution))))
+	}
+	return nmea.NewOptional(T(math.Round(value.Value / resolution)))
+}
+
+func radiansToDegrees(value nmea.Optional[float64]) nmea.Optional[float64] {
+	if !value.Valid {
+		return value
+	}
+	return nmea.NewOptional(value.Value * 180 / math.Pi)
+}
+
+func degreesToRadians(value nmea.Optional[float64]) nmea.Optional[float64] {
+	if !value.Valid {
+		return value
+	}
+	return nmea.NewOptional(value.Value * math.Pi / 180)
+}
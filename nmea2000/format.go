@@ -0,0 +1,209 @@
+package nmea2000
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Format is a text format for NMEA 2000 messages.
+type Format int
+
+const (
+	// FormatPlain is canboat's plain format, for example:
+	//
+	//	2023-01-02T03:04:05.678Z,2,127250,204,255,8,00,ff,ff,ff,ff,7f,ff,fd
+	FormatPlain Format = iota
+	// FormatActisenseASCII is Actisense's N2K ASCII format, for example:
+	//
+	//	A173321.107 23FF7 1F513 012F3070002F30709F
+	//
+	// Its timestamp is seconds and milliseconds since an unspecified epoch,
+	// and is read and written as Unix time.
+	FormatActisenseASCII
+)
+
+var (
+	errInvalidFormat = errors.New("invalid format")
+
+	plainTimestampLayouts = []string{
+		"2006-01-02T15:04:05.999999999Z07:00",
+		"2006-01-02-15:04:05.999999999",
+		"2006-01-02 15:04:05.999999999",
+	}
+)
+
+type FormatError struct {
+	Line string
+	Err  error
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("%q: %v", e.Line, e.Err)
+}
+
+func (e *FormatError) Unwrap() error {
+	return e.Err
+}
+
+func ParsePlain(line string) (*Message, error) {
+	fields := strings.Split(strings.TrimSpace(line), ",")
+	if len(fields) < 6 {
+		return nil, &FormatError{Line: line, Err: errInvalidFormat}
+	}
+	var m Message
+	var err error
+	for _, layout := range plainTimestampLayouts {
+		if m.Timestamp, err = time.Parse(layout, fields[0]); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, &FormatError{Line: line, Err: err}
+	}
+	var ints [5]int
+	for i := range ints {
+		if ints[i], err = strconv.Atoi(fields[i+1]); err != nil {
+			return nil, &FormatError{Line: line, Err: err}
+		}
+	}
+	m.Priority = ints[0]
+	m.PGN = PGN(ints[1])
+	m.Source = ints[2]
+	m.Destination = ints[3]
+	if length := ints[4]; length != len(fields)-6 {
+		return nil, &FormatError{Line: line, Err: errInvalidFormat}
+	}
+	m.Data = make([]byte, 0, len(fields)-6)
+	for _, field := range fields[6:] {
+		b, err := strconv.ParseUint(field, 16, 8)
+		if err != nil {
+			return nil, &FormatError{Line: line, Err: err}
+		}
+		m.Data = append(m.Data, byte(b))
+	}
+	return &m, nil
+}
+
+func ParseActisenseASCII(line string) (*Message, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 4 || !strings.HasPrefix(fields[0], "A") || len(fields[1]) != 5 {
+		return nil, &FormatError{Line: line, Err: errInvalidFormat}
+	}
+	var m Message
+	secondsStr, millisecondsStr, _ := strings.Cut(fields[0][1:], ".")
+	seconds, err := strconv.ParseInt(secondsStr, 10, 64)
+	if err != nil {
+		return nil, &FormatError{Line: line, Err: err}
+	}
+	var milliseconds int64
+	if millisecondsStr != "" {
+		millisecondsStr = (millisecondsStr + "00")[:3]
+		if milliseconds, err = strconv.ParseInt(millisecondsStr, 10, 64); err != nil {
+			return nil, &FormatError{Line: line, Err: err}
+		}
+	}
+	m.Timestamp = time.Unix(seconds, milliseconds*int64(time.Millisecond)).UTC()
+	addresses, err := strconv.ParseUint(fields[1], 16, 32)
+	if err != nil {
+		return nil, &FormatError{Line: line, Err: err}
+	}
+	m.Source = int(addresses >> 12 & 0xff)
+	m.Destination = int(addresses >> 4 & 0xff)
+	m.Priority = int(addresses & 0x0f)
+	pgn, err := strconv.ParseUint(fields[2], 16, 32)
+	if err != nil {
+		return nil, &FormatError{Line: line, Err: err}
+	}
+	m.PGN = PGN(pgn)
+	if m.Data, err = hex.DecodeString(fields[3]); err != nil {
+		return nil, &FormatError{Line: line, Err: err}
+	}
+	return &m, nil
+}
+
+func (m *Message) AppendPlain(b []byte) []byte {
+	b = m.Timestamp.UTC().AppendFormat(b, "2006-01-02T15:04:05.000Z07:00")
+	b = fmt.Appendf(b, ",%d,%d,%d,%d,%d", m.Priority, m.PGN, m.Source, m.Destination, len(m.Data))
+	for _, c := range m.Data {
+		b = fmt.Appendf(b, ",%02x", c)
+	}
+	return b
+}
+
+func (m *Message) AppendActisenseASCII(b []byte) []byte {
+	milliseconds := m.Timestamp.Nanosecond() / int(time.Millisecond)
+	b = fmt.Appendf(b, "A%d.%03d %02X%02X%X %05X %X",
+		m.Timestamp.Unix(), milliseconds,
+		m.Source, m.Destination, m.Priority,
+		int(m.PGN),
+		m.Data,
+	)
+	return b
+}
+
+func (m *Message) Append(b []byte, format Format) []byte {
+	switch format {
+	case FormatActisenseASCII:
+		return m.AppendActisenseASCII(b)
+	default:
+		return m.AppendPlain(b)
+	}
+}
+
+// A Reader reads NMEA 2000 messages from text, detecting the format of each
+// line. Blank lines and lines starting with # are skipped.
+type Reader struct {
+	scanner *bufio.Scanner
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		scanner: bufio.NewScanner(r),
+	}
+}
+
+func (r *Reader) Read() (*Message, error) {
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		switch {
+		case len(line) == 0 || line[0] == '#':
+			continue
+		case line[0] == 'A':
+			return ParseActisenseASCII(string(line))
+		default:
+			return ParsePlain(string(line))
+		}
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// A Writer writes NMEA 2000 messages as text, one per line.
+type Writer struct {
+	w      io.Writer
+	format Format
+	buffer []byte
+}
+
+func NewWriter(w io.Writer, format Format) *Writer {
+	return &Writer{
+		w:      w,
+		format: format,
+	}
+}
+
+func (w *Writer) Write(m *Message) error {
+	w.buffer = m.Append(w.buffer[:0], w.format)
+	w.buffer = append(w.buffer, '\n')
+	_, err := w.w.Write(w.buffer)
+	return err
+}
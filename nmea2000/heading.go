@@ -0,0 +1,49 @@
+package nmea2000
+
+import "github.com/twpayne/go-nmea"
+
+type HeadingReference int
+
+const (
+	HeadingReferenceTrue         HeadingReference = 0
+	HeadingReferenceMagnetic     HeadingReference = 1
+	HeadingReferenceError        HeadingReference = 2
+	HeadingReferenceNotAvailable HeadingReference = 3
+	headingReferenceMask                          = 0x03
+)
+
+type VesselHeading struct {
+	SequenceID nmea.Optional[int]
+	Heading    nmea.Optional[float64]
+	Deviation  nmea.Optional[float64]
+	Variation  nmea.Optional[float64]
+	Reference  HeadingReference
+}
+
+func DecodeVesselHeading(data []byte) (*VesselHeading, error) {
+	var vh VesselHeading
+	d := newFieldDecoder(data)
+	vh.SequenceID = d.OptionalUint8()
+	vh.Heading = d.OptionalAngleUint16()
+	vh.Deviation = d.OptionalAngleInt16()
+	vh.Variation = d.OptionalAngleInt16()
+	vh.Reference = HeadingReference(d.Uint8() & headingReferenceMask)
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	return &vh, nil
+}
+
+func (vh *VesselHeading) PGN() PGN {
+	return PGNVesselHeading
+}
+
+func (vh *VesselHeading) AppendData(data []byte) []byte {
+	e := fieldEncoder{data: data}
+	e.OptionalUint8(vh.SequenceID)
+	e.OptionalAngleUint16(vh.Heading)
+	e.OptionalAngleInt16(vh.Deviation)
+	e.OptionalAngleInt16(vh.Variation)
+	e.Uint8(0xfc | uint8(vh.Reference)&headingReferenceMask)
+	return e.data
+}
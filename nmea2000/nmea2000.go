@@ -0,0 +1,89 @@
+// Package nmea2000 converts between NMEA 2000 PGNs and NMEA 0183 sentences.
+//
+// Messages are read and written in the text formats used by canboat, so
+// conversions can be tested from log files without CAN hardware.
+//
+// See https://canboat.github.io/canboat/canboat.html.
+package nmea2000
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type PGN int
+
+const (
+	PGNSystemTime          PGN = 126992
+	PGNVesselHeading       PGN = 127250
+	PGNWaterDepth          PGN = 128267
+	PGNPositionRapidUpdate PGN = 129025
+	PGNCOGSOGRapidUpdate   PGN = 129026
+	PGNGNSSPositionData    PGN = 129029
+	PGNWindData            PGN = 130306
+)
+
+const (
+	BroadcastAddress = 255
+	maxSequenceID    = 252
+)
+
+var errShortData = errors.New("short data")
+
+type UnknownPGNError struct {
+	PGN PGN
+}
+
+func (e UnknownPGNError) Error() string {
+	return fmt.Sprintf("%d: unknown PGN", e.PGN)
+}
+
+type Message struct {
+	Timestamp   time.Time
+	Priority    int
+	PGN         PGN
+	Source      int
+	Destination int
+	Data        []byte
+}
+
+type Payload interface {
+	PGN() PGN
+	AppendData(data []byte) []byte
+}
+
+var payloadDecoderMap = map[PGN]func([]byte) (Payload, error){
+	PGNSystemTime:          makePayloadDecoder(DecodeSystemTime),
+	PGNVesselHeading:       makePayloadDecoder(DecodeVesselHeading),
+	PGNWaterDepth:          makePayloadDecoder(DecodeWaterDepth),
+	PGNPositionRapidUpdate: makePayloadDecoder(DecodePositionRapidUpdate),
+	PGNCOGSOGRapidUpdate:   makePayloadDecoder(DecodeCOGSOGRapidUpdate),
+	PGNGNSSPositionData:    makePayloadDecoder(DecodeGNSSPositionData),
+	PGNWindData:            makePayloadDecoder(DecodeWindData),
+}
+
+func NewMessage(priority int, payload Payload) *Message {
+	return &Message{
+		Priority:    priority,
+		PGN:         payload.PGN(),
+		Destination: BroadcastAddress,
+		Data:        payload.AppendData(nil),
+	}
+}
+
+func (m *Message) Decode() (Payload, error) {
+	payloadDecoder := payloadDecoderMap[m.PGN]
+	if payloadDecoder == nil {
+		return nil, UnknownPGNError{
+			PGN: m.PGN,
+		}
+	}
+	return payloadDecoder(m.Data)
+}
+
+func makePayloadDecoder[P Payload](f func([]byte) (P, error)) func([]byte) (Payload, error) {
+	return func(data []byte) (Payload, error) {
+		return f(data)
+	}
+}
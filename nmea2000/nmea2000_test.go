@@ -0,0 +1,214 @@
+package nmea2000_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/nmea2000"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+func TestDecode(t *testing.T) {
+	for _, tc := range []struct {
+		line     string
+		expected nmea2000.Payload
+	}{
+		{
+			line: "2002-07-04T20:15:30.000Z,3,126992,42,255,8,00,f0,60,2e,20,3c,78,2b",
+			expected: &nmea2000.SystemTime{
+				SequenceID: nmea.NewOptional(0),
+				Source:     nmea2000.TimeSourceGPS,
+				Time:       nmea.NewOptional(time.Date(2002, time.July, 4, 20, 15, 30, 0, time.UTC)),
+			},
+		},
+		{
+			line: "A1025813730.000 2AFF3 1F010 00F0602E203C782B",
+			expected: &nmea2000.SystemTime{
+				SequenceID: nmea.NewOptional(0),
+				Source:     nmea2000.TimeSourceGPS,
+				Time:       nmea.NewOptional(time.Date(2002, time.July, 4, 20, 15, 30, 0, time.UTC)),
+			},
+		},
+		{
+			line: "2002-07-04T20:15:30.000Z,2,129025,42,255,8,ab,27,2f,1c,39,f4,1a,05",
+			expected: &nmea2000.PositionRapidUpdate{
+				Lat: nmea.NewOptional(47.2852395),
+				Lon: nmea.NewOptional(8.5652537),
+			},
+		},
+		{
+			line: "2002-07-04-20:15:30.000,2,129025,42,255,8,ab,27,2f,1c,39,f4,1a,05",
+			expected: &nmea2000.PositionRapidUpdate{
+				Lat: nmea.NewOptional(47.2852395),
+				Lon: nmea.NewOptional(8.5652537),
+			},
+		},
+		{
+			line: "2002-07-04T20:15:30.000Z,2,129026,42,255,8,02,fc,da,34,00,00,ff,ff",
+			expected: &nmea2000.COGSOGRapidUpdate{
+				SequenceID:          nmea.NewOptional(2),
+				CourseOverGroundRef: nmea2000.HeadingReferenceTrue,
+				CourseOverGround:    nmea.NewOptional(77.52118968120038),
+				SpeedOverGroundMPS:  nmea.NewOptional(0.0),
+			},
+		},
+		{
+			line: "2002-07-04T20:15:30.000Z,3,129029,42,255,43,01,60,2e,d0,da,4a,14,80,f4,34,bc,b0,e8,8f,06,00,24,52,a1,a1,4c,30,01,80,b6,a3,20,00,00,00,00,10,fc,08,65,00,ff,7f,c0,12,00,00,00",
+			expected: &nmea2000.GNSSPositionData{
+				SequenceID:        nmea.NewOptional(1),
+				Time:              nmea.NewOptional(time.Date(2002, time.July, 4, 9, 27, 25, 0, time.UTC)),
+				Lat:               nmea.NewOptional(47.285233166666664),
+				Lon:               nmea.NewOptional(8.565265),
+				Alt:               nmea.NewOptional(547.6),
+				GNSSType:          nmea2000.GNSSTypeGPS,
+				Method:            nmea2000.GNSSMethodGNSSFix,
+				NumberOfSVs:       nmea.NewOptional(8),
+				HDOP:              nmea.NewOptional(1.01),
+				GeoidalSeparation: nmea.NewOptional(48.0),
+			},
+		},
+	} {
+		t.Run(tc.line, func(t *testing.T) {
+			message, err := nmea2000.NewReader(strings.NewReader(tc.line)).Read()
+			assert.NoError(t, err)
+			actual, err := message.Decode()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+			assert.Equal(t, message.Data, actual.AppendData(nil))
+		})
+	}
+}
+
+func TestFormats(t *testing.T) {
+	for _, tc := range []struct {
+		line   string
+		format nmea2000.Format
+	}{
+		{
+			line:   "2002-07-04T20:15:30.000Z,2,129025,42,255,8,ab,27,2f,1c,39,f4,1a,05",
+			format: nmea2000.FormatPlain,
+		},
+		{
+			line:   "A1025813730.250 2AFF2 1F801 AB272F1C39F41A05",
+			format: nmea2000.FormatActisenseASCII,
+		},
+	} {
+		t.Run(tc.line, func(t *testing.T) {
+			r := nmea2000.NewReader(strings.NewReader("# comment\n\n" + tc.line + "\n"))
+			message, err := r.Read()
+			assert.NoError(t, err)
+			assert.Equal(t, nmea2000.PGNPositionRapidUpdate, message.PGN)
+			assert.Equal(t, 42, message.Source)
+			assert.Equal(t, 255, message.Destination)
+			assert.Equal(t, 2, message.Priority)
+			var sb strings.Builder
+			assert.NoError(t, nmea2000.NewWriter(&sb, tc.format).Write(message))
+			assert.Equal(t, tc.line+"\n", sb.String())
+			_, err = r.Read()
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
+
+func TestBridge(t *testing.T) {
+	parser := nmea.NewParser(
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+		nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+	)
+	bridge := nmea2000.NewBridge(nmea2000.WithSource(42))
+	for _, tc := range []struct {
+		s                 string
+		expectedPGNs      []nmea2000.PGN
+		expectedSentences []nmea.Sentence
+	}{
+		{
+			s:            "$GPGGA,092725.00,4717.11399,N,00833.91590,E,1,08,1.01,499.6,M,48.0,M,,*5B",
+			expectedPGNs: []nmea2000.PGN{nmea2000.PGNGNSSPositionData},
+			expectedSentences: []nmea.Sentence{
+				&standard.GGA{
+					Address:                          nmea.NewAddress("IIGGA"),
+					Lat:                              nmea.NewOptional(47.285233166666664),
+					Lon:                              nmea.NewOptional(8.565265),
+					FixQuality:                       1,
+					NumberOfSatellites:               nmea.NewOptional(8),
+					HDOP:                             nmea.NewOptional(1.01),
+					Alt:                              nmea.NewOptional(499.6),
+					HeightOfGeoidAboveWGS84Ellipsoid: nmea.NewOptional(48.0),
+				},
+			},
+		},
+		{
+			s:            "$GPZDA,092725.00,04,07,2002,00,00*6E",
+			expectedPGNs: []nmea2000.PGN{nmea2000.PGNSystemTime},
+			expectedSentences: []nmea.Sentence{
+				&standard.ZDA{
+					Address: nmea.NewAddress("IIZDA"),
+					Time:    time.Date(2002, time.July, 4, 9, 27, 25, 0, time.UTC),
+				},
+			},
+		},
+		{
+			s:            "$GPGLL,4717.11364,N,00833.91565,E,092726.00,A,A*63",
+			expectedPGNs: []nmea2000.PGN{nmea2000.PGNPositionRapidUpdate},
+			expectedSentences: []nmea.Sentence{
+				&standard.GLL{
+					Address:   nmea.NewAddress("IIGLL"),
+					Lat:       nmea.NewOptional(47.2852273),
+					Lon:       nmea.NewOptional(8.5652608),
+					TimeOfDay: nmea.TimeOfDay{Hour: 9, Minute: 27, Second: 26},
+					Status:    'A',
+					PosMode:   'A',
+				},
+			},
+		},
+		{
+			s:            "$SDDPT,76.1,0.0,100*7A",
+			expectedPGNs: []nmea2000.PGN{nmea2000.PGNWaterDepth},
+			expectedSentences: []nmea.Sentence{
+				&standard.DPT{
+					Address: nmea.NewAddress("IIDPT"),
+					Depth:   76.1,
+					Offset:  nmea.NewOptional(0.0),
+					Maximum: nmea.NewOptional(100.0),
+				},
+			},
+		},
+		{
+			s:            "$WIMWV,214.8,R,0.1,K,A*28",
+			expectedPGNs: []nmea2000.PGN{nmea2000.PGNWindData},
+			expectedSentences: []nmea.Sentence{
+				&standard.MWV{
+					Address:       nmea.NewAddress("IIMWV"),
+					WindAngle:     nmea.NewOptional(214.80187739454564),
+					Reference:     'R',
+					WindSpeed:     nmea.NewOptional(0.03),
+					WindSpeedUnit: 'M',
+					Status:        'A',
+				},
+			},
+		},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			sentence, err := parser.ParseString(tc.s)
+			assert.NoError(t, err)
+			messages, err := bridge.Messages(sentence)
+			assert.NoError(t, err)
+			var actualPGNs []nmea2000.PGN
+			var actualSentences []nmea.Sentence
+			for _, message := range messages {
+				assert.Equal(t, 42, message.Source)
+				actualPGNs = append(actualPGNs, message.PGN)
+				sentences, err := bridge.Sentences(message)
+				assert.NoError(t, err)
+				actualSentences = append(actualSentences, sentences...)
+			}
+			assert.Equal(t, tc.expectedPGNs, actualPGNs)
+			assert.Equal(t, tc.expectedSentences, actualSentences)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package nmea2000
+
+import (
+	"time"
+
+	"github.com/twpayne/go-nmea"
+)
+
+type GNSSType int
+
+const (
+	GNSSTypeGPS            GNSSType = 0
+	GNSSTypeGLONASS        GNSSType = 1
+	GNSSTypeGPSGLONASS     GNSSType = 2
+	GNSSTypeGPSSBAS        GNSSType = 3
+	GNSSTypeGPSSBASGLONASS GNSSType = 4
+	GNSSTypeChayka         GNSSType = 5
+	GNSSTypeIntegrated     GNSSType = 6
+	GNSSTypeSurveyed       GNSSType = 7
+	GNSSTypeGalileo        GNSSType = 8
+	GNSSTypeNotAvailable   GNSSType = 15
+	gnssTypeMask                    = 0x0f
+)
+
+const (
+	ageOfCorrectionResolution   = 1e-2
+	altitudeResolution          = 1e-6
+	dopResolution               = 1e-2
+	geoidalSeparationResolution = 1e-2
+)
+
+// A GNSSMethod is the NMEA 2000 GNSS method. Its values match NMEA 0183 GGA
+// fix qualities.
+type GNSSMethod int
+
+const (
+	GNSSMethodNoGNSS       GNSSMethod = 0
+	GNSSMethodGNSSFix      GNSSMethod = 1
+	GNSSMethodDGNSSFix     GNSSMethod = 2
+	GNSSMethodPreciseGNSS  GNSSMethod = 3
+	GNSSMethodRTKFixed     GNSSMethod = 4
+	GNSSMethodRTKFloat     GNSSMethod = 5
+	GNSSMethodEstimated    GNSSMethod = 6
+	GNSSMethodManual       GNSSMethod = 7
+	GNSSMethodSimulated    GNSSMethod = 8
+	GNSSMethodNotAvailable GNSSMethod = 15
+)
+
+type PositionRapidUpdate struct {
+	Lat nmea.Optional[float64]
+	Lon nmea.Optional[float64]
+}
+
+type ReferenceStation struct {
+	Type            int
+	ID              int
+	AgeOfCorrection nmea.Optional[float64]
+}
+
+type GNSSPositionData struct {
+	SequenceID        nmea.Optional[int]
+	Time              nmea.Optional[time.Time]
+	Lat               nmea.Optional[float64]
+	Lon               nmea.Optional[float64]
+	Alt               nmea.Optional[float64]
+	GNSSType          GNSSType
+	Method            GNSSMethod
+	Integrity         int
+	NumberOfSVs       nmea.Optional[int]
+	HDOP              nmea.Optional[float64]
+	PDOP              nmea.Optional[float64]
+	GeoidalSeparation nmea.Optional[float64]
+	ReferenceStations []ReferenceStation
+}
+
+func DecodePositionRapidUpdate(data []byte) (*PositionRapidUpdate, error) {
+	var pru PositionRapidUpdate
+	d := newFieldDecoder(data)
+	pru.Lat = d.OptionalScaledInt32(latLonResolution)
+	pru.Lon = d.OptionalScaledInt32(latLonResolution)
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	return &pru, nil
+}
+
+func (pru *PositionRapidUpdate) PGN() PGN {
+	return PGNPositionRapidUpdate
+}
+
+func (pru *PositionRapidUpdate) AppendData(data []byte) []byte {
+	e := fieldEncoder{data: data}
+	e.OptionalScaledInt32(pru.Lat, latLonResolution)
+	e.OptionalScaledInt32(pru.Lon, latLonResolution)
+	return e.data
+}
+
+func DecodeGNSSPositionData(data []byte) (*GNSSPositionData, error) {
+	var gpd GNSSPositionData
+	d := newFieldDecoder(data)
+	gpd.SequenceID = d.OptionalUint8()
+	gpd.Time = decodeDateTime(d.OptionalUint16(), d.OptionalUint32())
+	gpd.Lat = d.OptionalScaledInt64(latLon64Resolution)
+	gpd.Lon = d.OptionalScaledInt64(latLon64Resolution)
+	gpd.Alt = d.OptionalScaledInt64(altitudeResolution)
+	typeAndMethod := d.Uint8()
+	gpd.GNSSType = GNSSType(typeAndMethod & gnssTypeMask)
+	gpd.Method = GNSSMethod(typeAndMethod >> 4)
+	gpd.Integrity = int(d.Uint8() & 0x03)
+	gpd.NumberOfSVs = d.OptionalUint8()
+	gpd.HDOP = d.OptionalScaledInt16(dopResolution)
+	gpd.PDOP = d.OptionalScaledInt16(dopResolution)
+	gpd.GeoidalSeparation = d.OptionalScaledInt32(geoidalSeparationResolution)
+	numberOfReferenceStations := d.OptionalUint8()
+	for i := 0; i < numberOfReferenceStations.Value; i++ {
+		typeAndID := d.OptionalUint16()
+		rs := ReferenceStation{
+			Type:            typeAndID.Value & 0x0f,
+			ID:              typeAndID.Value >> 4,
+			AgeOfCorrection: d.OptionalScaledUint16(ageOfCorrectionResolution),
+		}
+		gpd.ReferenceStations = append(gpd.ReferenceStations, rs)
+	}
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	return &gpd, nil
+}
+
+func (gpd *GNSSPositionData) PGN() PGN {
+	return PGNGNSSPositionData
+}
+
+func (gpd *GNSSPositionData) AppendData(data []byte) []byte {
+	e := fieldEncoder{data: data}
+	e.OptionalUint8(gpd.SequenceID)
+	days, ticks := encodeDateTime(gpd.Time)
+	e.OptionalUint16(days)
+	e.OptionalUint32(ticks)
+	e.OptionalScaledInt64(gpd.Lat, latLon64Resolution)
+	e.OptionalScaledInt64(gpd.Lon, latLon64Resolution)
+	e.OptionalScaledInt64(gpd.Alt, altitudeResolution)
+	e.Uint8(uint8(gpd.Method)<<4 | uint8(gpd.GNSSType)&gnssTypeMask)
+	e.Uint8(0xfc | uint8(gpd.Integrity)&0x03)
+	e.OptionalUint8(gpd.NumberOfSVs)
+	e.OptionalScaledInt16(gpd.HDOP, dopResolution)
+	e.OptionalScaledInt16(gpd.PDOP, dopResolution)
+	e.OptionalScaledInt32(gpd.GeoidalSeparation, geoidalSeparationResolution)
+	e.Uint8(uint8(len(gpd.ReferenceStations)))
+	for _, rs := range gpd.ReferenceStations {
+		e.OptionalUint16(nmea.NewOptional(rs.ID<<4 | rs.Type&0x0f))
+		e.OptionalScaledUint16(rs.AgeOfCorrection, ageOfCorrectionResolution)
+	}
+	return e.data
+}
@@ -0,0 +1,73 @@
+package nmea2000
+
+import (
+	"time"
+
+	"github.com/twpayne/go-nmea"
+)
+
+type TimeSource int
+
+const (
+	TimeSourceGPS                TimeSource = 0
+	TimeSourceGLONASS            TimeSource = 1
+	TimeSourceRadioStation       TimeSource = 2
+	TimeSourceLocalCesiumClock   TimeSource = 3
+	TimeSourceLocalRubidiumClock TimeSource = 4
+	TimeSourceLocalCrystalClock  TimeSource = 5
+	TimeSourceNotAvailable       TimeSource = 15
+	timeSourceMask                          = 0x0f
+	timeTick                                = 100 * time.Microsecond
+)
+
+type SystemTime struct {
+	SequenceID nmea.Optional[int]
+	Source     TimeSource
+	Time       nmea.Optional[time.Time]
+}
+
+func DecodeSystemTime(data []byte) (*SystemTime, error) {
+	var st SystemTime
+	d := newFieldDecoder(data)
+	st.SequenceID = d.OptionalUint8()
+	st.Source = TimeSource(d.Uint8() & timeSourceMask)
+	st.Time = decodeDateTime(d.OptionalUint16(), d.OptionalUint32())
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+func (st *SystemTime) PGN() PGN {
+	return PGNSystemTime
+}
+
+func (st *SystemTime) AppendData(data []byte) []byte {
+	e := fieldEncoder{data: data}
+	e.OptionalUint8(st.SequenceID)
+	e.Uint8(0xf0 | uint8(st.Source)&timeSourceMask)
+	days, ticks := encodeDateTime(st.Time)
+	e.OptionalUint16(days)
+	e.OptionalUint32(ticks)
+	return e.data
+}
+
+func decodeDateTime(days, ticks nmea.Optional[int]) nmea.Optional[time.Time] {
+	if !days.Valid || !ticks.Valid {
+		return nmea.Optional[time.Time]{}
+	}
+	return nmea.NewOptional(time.Unix(0, 0).UTC().
+		AddDate(0, 0, days.Value).
+		Add(time.Duration(ticks.Value) * timeTick))
+}
+
+func encodeDateTime(t nmea.Optional[time.Time]) (nmea.Optional[int], nmea.Optional[int]) {
+	if !t.Valid {
+		return nmea.Optional[int]{}, nmea.Optional[int]{}
+	}
+	utc := t.Value.UTC()
+	midnight := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(midnight.Unix() / (24 * 60 * 60))
+	ticks := int(utc.Sub(midnight) / timeTick)
+	return nmea.NewOptional(days), nmea.NewOptional(ticks)
+}
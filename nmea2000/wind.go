@@ -0,0 +1,48 @@
+package nmea2000
+
+import "github.com/twpayne/go-nmea"
+
+type WindReference int
+
+const (
+	WindReferenceTrueGroundNorth     WindReference = 0
+	WindReferenceMagneticGroundNorth WindReference = 1
+	WindReferenceApparent            WindReference = 2
+	WindReferenceTrueBoat            WindReference = 3
+	WindReferenceTrueWater           WindReference = 4
+	windReferenceMask                              = 0x07
+)
+
+type WindData struct {
+	SequenceID   nmea.Optional[int]
+	WindSpeedMPS nmea.Optional[float64]
+	WindAngle    nmea.Optional[float64]
+	Reference    WindReference
+}
+
+func DecodeWindData(data []byte) (*WindData, error) {
+	var wd WindData
+	d := newFieldDecoder(data)
+	wd.SequenceID = d.OptionalUint8()
+	wd.WindSpeedMPS = d.OptionalScaledUint16(speedResolution)
+	wd.WindAngle = d.OptionalAngleUint16()
+	wd.Reference = WindReference(d.Uint8() & windReferenceMask)
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	return &wd, nil
+}
+
+func (wd *WindData) PGN() PGN {
+	return PGNWindData
+}
+
+func (wd *WindData) AppendData(data []byte) []byte {
+	e := fieldEncoder{data: data}
+	e.OptionalUint8(wd.SequenceID)
+	e.OptionalScaledUint16(wd.WindSpeedMPS, speedResolution)
+	e.OptionalAngleUint16(wd.WindAngle)
+	e.Uint8(0xf8 | uint8(wd.Reference)&windReferenceMask)
+	e.Reserved(2)
+	return e.data
+}
@@ -2,9 +2,7 @@
 package nmeatest
 
 import (
-	"errors"
 	"slices"
-	"strings"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
@@ -35,19 +33,29 @@ func TestSentenceParserFunc(t *testing.T, options []nmea.ParserOption, testCases
 			if testCase.ExpectedErr != nil {
 				assert.IsError(t, err, testCase.ExpectedErr)
 			} else {
-				var syntaxError *nmea.SyntaxError
-				if errors.As(err, &syntaxError) {
-					t.Fatalf(
-						"Did not expect an error but got:\n"+
-							"%s\n"+
-							"%s^syntax error: %v",
-						syntaxError.Data,
-						strings.Repeat(" ", syntaxError.Pos), syntaxError.Err,
-					)
+				if err != nil {
+					t.Fatalf("Did not expect an error but got:\n%s", nmea.FormatError(err))
 				}
 				assert.NoError(t, err)
 				assert.Equal(t, testCase.Expected, actual)
+				if encoder, ok := actual.(nmea.SentenceEncoder); ok {
+					testRoundTrip(t, testCaseOptions, encoder)
+				}
 			}
 		})
 	}
 }
+
+// testRoundTrip tests that encoding and then parsing s returns s.
+func testRoundTrip(t *testing.T, options []nmea.ParserOption, s nmea.SentenceEncoder) {
+	t.Helper()
+	data, err := nmea.Marshal(s)
+	assert.NoError(t, err)
+	options = append(slices.Clone(options),
+		nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineStrict),
+	)
+	actual, err := nmea.NewParser(options...).Parse(data)
+	assert.NoError(t, err, "%q", data)
+	assert.Equal(t, nmea.Sentence(s), actual, "%q", data)
+}
@@ -0,0 +1,54 @@
+package novatel
+
+import "github.com/twpayne/go-nmea/standard"
+
+// A FixQuality is a NovAtel GGA fix quality.
+type FixQuality int
+
+// Fix qualities.
+const (
+	FixQualityInvalid                 FixQuality = 0
+	FixQualitySinglePoint             FixQuality = 1
+	FixQualityPseudorangeDifferential FixQuality = 2
+	FixQualityRTKFixed                FixQuality = 4
+	FixQualityRTKFloat                FixQuality = 5
+	FixQualityDeadReckoning           FixQuality = 6
+	FixQualityManualInput             FixQuality = 7
+	FixQualitySimulator               FixQuality = 8
+	FixQualitySBAS                    FixQuality = 9
+)
+
+var fixQualityStrings = map[FixQuality]string{
+	FixQualityInvalid:                 "invalid",
+	FixQualitySinglePoint:             "single point",
+	FixQualityPseudorangeDifferential: "pseudorange differential",
+	FixQualityRTKFixed:                "RTK fixed",
+	FixQualityRTKFloat:                "RTK float",
+	FixQualityDeadReckoning:           "dead reckoning",
+	FixQualityManualInput:             "manual input",
+	FixQualitySimulator:               "simulator",
+	FixQualitySBAS:                    "SBAS",
+}
+
+// FixQualityOf returns the NovAtel fix quality of gga.
+func FixQualityOf(gga *standard.GGA) FixQuality {
+	return FixQuality(gga.FixQuality)
+}
+
+// RTK returns whether q is an RTK solution, either float or fixed.
+func (q FixQuality) RTK() bool {
+	return q == FixQualityRTKFixed || q == FixQualityRTKFloat
+}
+
+// RTKFixed returns whether q is an RTK solution with fixed integer
+// ambiguities.
+func (q FixQuality) RTKFixed() bool {
+	return q == FixQualityRTKFixed
+}
+
+func (q FixQuality) String() string {
+	if s, ok := fixQualityStrings[q]; ok {
+		return s
+	}
+	return "unknown"
+}
@@ -0,0 +1,25 @@
+// Package novatel parses NovAtel NMEA sentences.
+//
+// NovAtel receivers output standard NMEA sentences, parsed by package
+// standard, with NovAtel-specific fix qualities, and SPAN receivers output
+// inertial attitude in PASHR sentences, parsed by package ashtech.
+//
+// See https://docs.novatel.com/OEM7/Content/Logs/Core_Logs.htm.
+package novatel
+
+import (
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/ashtech"
+)
+
+// A PASHR is a SPAN inertial attitude sentence. SPAN receivers output the
+// same PASHR sentence as Ashtech receivers, so it is an ashtech.PASHR.
+type PASHR = ashtech.PASHR
+
+var sentenceParserMap = nmea.SentenceParserMap{
+	"PASHR": ashtech.ParseSentence,
+}
+
+func SentenceParserFunc(addr string) nmea.SentenceParser {
+	return sentenceParserMap[addr]
+}
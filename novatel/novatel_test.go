@@ -0,0 +1,73 @@
+package novatel_test
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/ashtech"
+	"github.com/twpayne/go-nmea/nmeatest"
+	"github.com/twpayne/go-nmea/novatel"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+func TestSentenceParserFunc(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(novatel.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$PASHR,195124.00,305.30,T,+0.05,-0.13,,0.026,0.025,0.171,2,1*37",
+				Expected: &novatel.PASHR{
+					Address: nmea.NewAddress("PASHR"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   19,
+						Minute: 51,
+						Second: 24,
+					},
+					Heading:         305.3,
+					Roll:            0.05,
+					Pitch:           -0.13,
+					RollAccuracy:    nmea.NewOptional(0.026),
+					PitchAccuracy:   nmea.NewOptional(0.025),
+					HeadingAccuracy: nmea.NewOptional(0.171),
+					GNSSQuality:     nmea.NewOptional(ashtech.GNSSQualityRTK),
+					INSStatus:       nmea.NewOptional(ashtech.INSStatusPostAlignment),
+				},
+			},
+			{
+				S: "$PASHR,195124.00,305.30,T,+0.05,-0.13,,,,,,*1E",
+				Expected: &novatel.PASHR{
+					Address: nmea.NewAddress("PASHR"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   19,
+						Minute: 51,
+						Second: 24,
+					},
+					Heading: 305.3,
+					Roll:    0.05,
+					Pitch:   -0.13,
+				},
+			},
+		})
+}
+
+func TestFixQualityOf(t *testing.T) {
+	parser := nmea.NewParser(
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+		nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+	)
+	sentence, err := parser.ParseString("$GPGGA,202530.00,5109.0262,N,11401.8407,W,5,40,0.5,1097.36,M,-17.00,M,18,TSTR*61")
+	assert.NoError(t, err)
+	gga, ok := sentence.(*standard.GGA)
+	assert.True(t, ok)
+	fixQuality := novatel.FixQualityOf(gga)
+	assert.Equal(t, novatel.FixQualityRTKFloat, fixQuality)
+	assert.True(t, fixQuality.RTK())
+	assert.False(t, fixQuality.RTKFixed())
+	assert.Equal(t, "RTK float", fixQuality.String())
+}
@@ -0,0 +1,183 @@
+package ntrip
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+// casterQueueLength is the number of broadcasts queued for each client.
+// Broadcasts to clients with full queues are dropped.
+const casterQueueLength = 64
+
+// A Caster is a minimal NTRIP caster for testing. It serves the data passed
+// to Broadcast to the clients connected to each mountpoint and reports the
+// GGA sentences that clients send.
+type Caster struct {
+	username    string
+	password    string
+	ggaFunc     func(mountpoint string, gga *standard.GGA)
+	parser      *nmea.Parser
+	mutex       sync.Mutex
+	mountpoints map[string]map[chan []byte]struct{}
+}
+
+type CasterOption func(*Caster)
+
+// WithCasterCredentials sets the username and password that clients must
+// send.
+func WithCasterCredentials(username, password string) CasterOption {
+	return func(c *Caster) {
+		c.username = username
+		c.password = password
+	}
+}
+
+// WithGGAFunc sets a function that is called with every GGA sentence sent by
+// a client.
+func WithGGAFunc(ggaFunc func(mountpoint string, gga *standard.GGA)) CasterOption {
+	return func(c *Caster) {
+		c.ggaFunc = ggaFunc
+	}
+}
+
+// NewCaster returns a new Caster that serves mountpoints.
+func NewCaster(mountpoints []string, options ...CasterOption) *Caster {
+	c := &Caster{
+		parser: nmea.NewParser(
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineIgnore),
+			nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+		),
+		mountpoints: make(map[string]map[chan []byte]struct{}),
+	}
+	for _, mountpoint := range mountpoints {
+		c.mountpoints[mountpoint] = make(map[chan []byte]struct{})
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Broadcast sends data to every client connected to mountpoint.
+func (c *Caster) Broadcast(mountpoint string, data []byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for ch := range c.mountpoints[mountpoint] {
+		select {
+		case ch <- data:
+		default:
+		}
+	}
+}
+
+// Serve accepts connections on listener until listener is closed.
+func (c *Caster) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		go c.serveConn(conn)
+	}
+}
+
+func (c *Caster) serveConn(conn net.Conn) {
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+	req, err := http.ReadRequest(br)
+	if err != nil {
+		return
+	}
+	version := Version1
+	if req.Header.Get("Ntrip-Version") == ntripVersion2 {
+		version = Version2
+	}
+	mountpoint := strings.TrimPrefix(req.URL.Path, "/")
+
+	if c.username != "" || c.password != "" {
+		if req.Header.Get("Authorization") != basicAuthorization(c.username, c.password) {
+			writeStatus(conn, version, http.StatusUnauthorized)
+			return
+		}
+	}
+
+	c.mutex.Lock()
+	clients, ok := c.mountpoints[mountpoint]
+	ch := make(chan []byte, casterQueueLength)
+	if ok {
+		clients[ch] = struct{}{}
+	}
+	c.mutex.Unlock()
+	if !ok {
+		writeStatus(conn, version, http.StatusNotFound)
+		return
+	}
+	defer func() {
+		c.mutex.Lock()
+		delete(clients, ch)
+		c.mutex.Unlock()
+	}()
+
+	var w io.Writer = conn
+	switch version {
+	case Version1:
+		if _, err := io.WriteString(conn, "ICY 200 OK\r\n"); err != nil {
+			return
+		}
+	case Version2:
+		if _, err := io.WriteString(conn, ""+
+			"HTTP/1.1 200 OK\r\n"+
+			"Ntrip-Version: "+ntripVersion2+"\r\n"+
+			"Content-Type: "+contentTypeGNSSData+"\r\n"+
+			"Cache-Control: no-store, no-cache, max-age=0\r\n"+
+			"Transfer-Encoding: chunked\r\n"+
+			"\r\n"); err != nil {
+			return
+		}
+		w = httputil.NewChunkedWriter(conn)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for sentence, err := range nmea.Sentences(c.parser, br) {
+			if err != nil {
+				continue
+			}
+			if gga, ok := sentence.(*standard.GGA); ok && c.ggaFunc != nil {
+				c.ggaFunc(mountpoint, gga)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		case data := <-ch:
+			if _, err := w.Write(data); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func writeStatus(w io.Writer, version, statusCode int) {
+	proto := "HTTP/1.0"
+	if version == Version2 {
+		proto = "HTTP/1.1"
+	}
+	_, _ = fmt.Fprintf(w, "%s %d %s\r\n\r\n", proto, statusCode, http.StatusText(statusCode))
+}
@@ -0,0 +1,302 @@
+package ntrip
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+const defaultGGAInterval = 10 * time.Second
+
+// A Client connects to a mountpoint on an NTRIP caster.
+type Client struct {
+	addr         string
+	mountpoint   string
+	version      int
+	username     string
+	password     string
+	userAgent    string
+	ggaInterval  time.Duration
+	parser       *nmea.Parser
+	sentenceFunc func(nmea.Sentence)
+}
+
+type ClientOption func(*Client)
+
+// WithCredentials sets the username and password sent to the caster.
+func WithCredentials(username, password string) ClientOption {
+	return func(c *Client) {
+		c.username = username
+		c.password = password
+	}
+}
+
+// WithGGAInterval sets the interval at which Run sends the rover's position
+// to the caster. The default is ten seconds. If ggaInterval is zero or
+// negative then Run sends every GGA sentence as soon as it is read.
+func WithGGAInterval(ggaInterval time.Duration) ClientOption {
+	return func(c *Client) {
+		c.ggaInterval = ggaInterval
+	}
+}
+
+// WithParser sets the parser that Run uses to parse sentences from the
+// receiver. It must parse GGA sentences into *standard.GGAs.
+func WithParser(parser *nmea.Parser) ClientOption {
+	return func(c *Client) {
+		c.parser = parser
+	}
+}
+
+// WithSentenceFunc sets a function that Run calls with every sentence that
+// it reads from the receiver.
+func WithSentenceFunc(sentenceFunc func(nmea.Sentence)) ClientOption {
+	return func(c *Client) {
+		c.sentenceFunc = sentenceFunc
+	}
+}
+
+// WithUserAgent sets the user agent sent to the caster.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithVersion sets the NTRIP version, either Version1 or Version2. The
+// default is Version2.
+func WithVersion(version int) ClientOption {
+	return func(c *Client) {
+		c.version = version
+	}
+}
+
+// NewClient returns a new Client that connects to mountpoint on the caster
+// at addr, which is of the form host:port.
+func NewClient(addr, mountpoint string, options ...ClientOption) *Client {
+	c := &Client{
+		addr:        addr,
+		mountpoint:  mountpoint,
+		version:     Version2,
+		userAgent:   "NTRIP go-nmea",
+		ggaInterval: defaultGGAInterval,
+		parser: nmea.NewParser(
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineIgnore),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineIgnore),
+			nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+		),
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// A Conn is a connection to a mountpoint. Reading from a Conn returns the
+// data sent by the caster, typically RTCM 3 frames.
+type Conn struct {
+	conn    net.Conn
+	r       io.Reader
+	writeMu sync.Mutex
+}
+
+// Connect connects to the caster and requests the mountpoint.
+func (c *Client) Connect(ctx context.Context) (*Conn, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	r, err := c.request(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return &Conn{
+		conn: conn,
+		r:    r,
+	}, nil
+}
+
+// Run connects to the caster and copies the data that it sends to receiver.
+// It reads sentences from receiver and sends the most recent GGA sentence to
+// the caster as soon as the first one is read and then every GGA interval.
+// Run returns when ctx is canceled or on the first error. Reading from
+// receiver continues in a separate goroutine until the next read after Run
+// returns.
+func (c *Client) Run(ctx context.Context, receiver io.ReadWriter) error {
+	conn, err := c.Connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make(chan error, 2)
+	ggas := make(chan *standard.GGA, 1)
+	go func() {
+		_, err := io.Copy(receiver, conn)
+		if err == nil {
+			err = io.EOF
+		}
+		errs <- err
+	}()
+	go func() {
+		er := &errReader{r: receiver}
+		for sentence, err := range nmea.Sentences(c.parser, er) {
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				// Skip sentences that cannot be parsed. Read errors are
+				// handled below.
+				continue
+			}
+			if c.sentenceFunc != nil {
+				c.sentenceFunc(sentence)
+			}
+			if gga, ok := sentence.(*standard.GGA); ok {
+				// Replace any unsent GGA with the latest.
+				select {
+				case <-ggas:
+				default:
+				}
+				ggas <- gga
+			}
+		}
+		err := er.err
+		if err == nil {
+			err = io.EOF
+		}
+		errs <- err
+	}()
+
+	var latestGGA *standard.GGA
+	var tickerC <-chan time.Time
+	if c.ggaInterval > 0 {
+		ticker := time.NewTicker(c.ggaInterval)
+		defer ticker.Stop()
+		tickerC = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errs:
+			return err
+		case gga := <-ggas:
+			if latestGGA == nil || tickerC == nil {
+				if err := conn.SendGGA(gga); err != nil {
+					return err
+				}
+			}
+			latestGGA = gga
+		case <-tickerC:
+			if latestGGA == nil {
+				continue
+			}
+			if err := conn.SendGGA(latestGGA); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// request sends the request for the mountpoint and reads the response,
+// returning a reader for the data that follows.
+func (c *Client) request(conn net.Conn) (io.Reader, error) {
+	var sb strings.Builder
+	switch c.version {
+	case Version1:
+		fmt.Fprintf(&sb, "GET /%s HTTP/1.0\r\n", c.mountpoint)
+	case Version2:
+		fmt.Fprintf(&sb, "GET /%s HTTP/1.1\r\n", c.mountpoint)
+		fmt.Fprintf(&sb, "Host: %s\r\n", c.addr)
+		fmt.Fprintf(&sb, "Ntrip-Version: %s\r\n", ntripVersion2)
+	default:
+		return nil, fmt.Errorf("%d: unsupported NTRIP version", c.version)
+	}
+	fmt.Fprintf(&sb, "User-Agent: %s\r\n", c.userAgent)
+	if c.username != "" || c.password != "" {
+		fmt.Fprintf(&sb, "Authorization: %s\r\n", basicAuthorization(c.username, c.password))
+	}
+	sb.WriteString("\r\n")
+	if _, err := io.WriteString(conn, sb.String()); err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	if c.version == Version1 {
+		statusLine, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		if status := strings.TrimRight(statusLine, "\r\n"); status != "ICY 200 OK" {
+			return nil, &StatusError{
+				Status: status,
+			}
+		}
+		return br, nil
+	}
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, &StatusError{
+			Status: resp.Status,
+		}
+	}
+	return resp.Body, nil
+}
+
+// An errReader records the first error returned by r.
+type errReader struct {
+	r   io.Reader
+	err error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
+// Close closes c.
+func (c *Conn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Conn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+// SendGGA sends gga to the caster.
+func (c *Conn) SendGGA(gga *standard.GGA) error {
+	data, err := nmea.Marshal(gga)
+	if err != nil {
+		return err
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, err = c.conn.Write(data)
+	return err
+}
@@ -0,0 +1,35 @@
+// Package ntrip implements an NTRIP client that feeds RTCM corrections to an
+// RTK rover, and a minimal caster for testing.
+//
+// Both NTRIP version 1, which uses an HTTP-like protocol with an ICY status
+// line, and NTRIP version 2, which uses HTTP/1.1 with chunked transfer
+// encoding, are supported.
+package ntrip
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+const (
+	Version1 = 1
+	Version2 = 2
+)
+
+const (
+	contentTypeGNSSData = "gnss/data"
+	ntripVersion2       = "Ntrip/2.0"
+)
+
+// A StatusError is returned when the caster rejects a request.
+type StatusError struct {
+	Status string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: unexpected status", e.Status)
+}
+
+func basicAuthorization(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
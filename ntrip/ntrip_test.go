@@ -0,0 +1,175 @@
+package ntrip_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/ntrip"
+	"github.com/twpayne/go-nmea/rtcm3"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+const ggaSentence = "$GPGGA,092725.00,4717.11399,N,00833.91590,E,4,08,1.01,499.6,M,48,M,1,0123*71\r\n"
+
+// A fakeReceiver is a receiver whose output is written to by the test and
+// whose input is read by the test.
+type fakeReceiver struct {
+	io.Reader
+	io.Writer
+}
+
+type ggaEvent struct {
+	mountpoint string
+	gga        *standard.GGA
+}
+
+func startCaster(t *testing.T, options ...ntrip.CasterOption) (*ntrip.Caster, string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		listener.Close()
+	})
+	caster := ntrip.NewCaster([]string{"RTCM3"}, options...)
+	go func() {
+		_ = caster.Serve(listener)
+	}()
+	return caster, listener.Addr().String()
+}
+
+func TestClientRun(t *testing.T) {
+	for _, version := range []int{ntrip.Version1, ntrip.Version2} {
+		t.Run(map[int]string{ntrip.Version1: "v1", ntrip.Version2: "v2"}[version], func(t *testing.T) {
+			ggaEvents := make(chan ggaEvent, 16)
+			caster, addr := startCaster(t,
+				ntrip.WithCasterCredentials("user", "pass"),
+				ntrip.WithGGAFunc(func(mountpoint string, gga *standard.GGA) {
+					ggaEvents <- ggaEvent{mountpoint: mountpoint, gga: gga}
+				}),
+			)
+
+			receiverOutputR, receiverOutputW := io.Pipe()
+			receiverInputR, receiverInputW := io.Pipe()
+			defer receiverOutputW.Close()
+			receiver := &fakeReceiver{
+				Reader: receiverOutputR,
+				Writer: receiverInputW,
+			}
+
+			var sentences []nmea.Sentence
+			client := ntrip.NewClient(addr, "RTCM3",
+				ntrip.WithCredentials("user", "pass"),
+				ntrip.WithVersion(version),
+				ntrip.WithGGAInterval(time.Hour),
+				ntrip.WithSentenceFunc(func(sentence nmea.Sentence) {
+					sentences = append(sentences, sentence)
+				}),
+			)
+			ctx, cancel := context.WithCancel(context.Background())
+			runErr := make(chan error, 1)
+			go func() {
+				runErr <- client.Run(ctx, receiver)
+			}()
+
+			_, err := io.WriteString(receiverOutputW, "$PGRMZ,5584,F,2*06\r\n"+ggaSentence)
+			assert.NoError(t, err)
+
+			event := <-ggaEvents
+			assert.Equal(t, "RTCM3", event.mountpoint)
+			assert.Equal(t, nmea.NewOptional(499.6), event.gga.Alt)
+			assert.Equal(t, 4, event.gga.FixQuality)
+
+			frame, err := rtcm3.NewFrame((&rtcm3.StationaryReferenceStation{StationID: 1}).AppendPayload(nil))
+			assert.NoError(t, err)
+			caster.Broadcast("RTCM3", frame.Data)
+			actual := make([]byte, len(frame.Data))
+			_, err = io.ReadFull(receiverInputR, actual)
+			assert.NoError(t, err)
+			assert.Equal(t, frame.Data, actual)
+
+			cancel()
+			assert.IsError(t, <-runErr, context.Canceled)
+			assert.Equal(t, 2, len(sentences))
+		})
+	}
+}
+
+func TestClientRunZeroGGAInterval(t *testing.T) {
+	ggaEvents := make(chan ggaEvent, 16)
+	_, addr := startCaster(t,
+		ntrip.WithGGAFunc(func(mountpoint string, gga *standard.GGA) {
+			ggaEvents <- ggaEvent{mountpoint: mountpoint, gga: gga}
+		}),
+	)
+
+	receiverOutputR, receiverOutputW := io.Pipe()
+	defer receiverOutputW.Close()
+	receiver := &fakeReceiver{
+		Reader: receiverOutputR,
+		Writer: io.Discard,
+	}
+
+	client := ntrip.NewClient(addr, "RTCM3", ntrip.WithGGAInterval(0))
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- client.Run(ctx, receiver)
+	}()
+
+	for range 2 {
+		_, err := io.WriteString(receiverOutputW, ggaSentence)
+		assert.NoError(t, err)
+		event := <-ggaEvents
+		assert.Equal(t, "RTCM3", event.mountpoint)
+	}
+
+	cancel()
+	assert.IsError(t, <-runErr, context.Canceled)
+}
+
+func TestClientConnectErrors(t *testing.T) {
+	_, addr := startCaster(t, ntrip.WithCasterCredentials("user", "pass"))
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name           string
+		mountpoint     string
+		options        []ntrip.ClientOption
+		expectedStatus string
+	}{
+		{
+			name:       "v1_unauthorized",
+			mountpoint: "RTCM3",
+			options: []ntrip.ClientOption{
+				ntrip.WithVersion(ntrip.Version1),
+			},
+			expectedStatus: "HTTP/1.0 401 Unauthorized",
+		},
+		{
+			name:           "v2_unauthorized",
+			mountpoint:     "RTCM3",
+			expectedStatus: "401 Unauthorized",
+		},
+		{
+			name:       "v2_not_found",
+			mountpoint: "MISSING",
+			options: []ntrip.ClientOption{
+				ntrip.WithCredentials("user", "pass"),
+			},
+			expectedStatus: "404 Not Found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ntrip.NewClient(addr, tc.mountpoint, tc.options...).Connect(ctx)
+			var statusError *ntrip.StatusError
+			assert.True(t, errors.As(err, &statusError))
+			assert.Equal(t, tc.expectedStatus, statusError.Status)
+		})
+	}
+}
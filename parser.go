@@ -4,10 +4,9 @@ package nmea
 // FIXME communicate whether checksum was valid, invalid, or absent
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
-	"regexp"
+	"sync"
 )
 
 type ChecksumDiscipline int
@@ -29,16 +28,24 @@ const (
 )
 
 var (
-	sentenceRx = regexp.MustCompile(`\A\$([^$*]+)\*(.{2})?(\r?\n)?\z`)
-
-	errFraming              = errors.New("framing error")
-	errInvalidLineEnding    = errors.New("invalid line ending")
-	errMissingChecksum      = errors.New("missing checksum")
-	errMissingLineEnding    = errors.New("missing line ending")
-	errUnexpectedChecksum   = errors.New("unexpected checksum")
-	errUnexpectedLineEnding = errors.New("unexpected line ending")
+	tokenizerPool = sync.Pool{
+		New: func() any {
+			return &Tokenizer{}
+		},
+	}
+
+	ErrFraming              = errors.New("framing error")
+	ErrInvalidLineEnding    = errors.New("invalid line ending")
+	ErrMissingChecksum      = errors.New("missing checksum")
+	ErrMissingLineEnding    = errors.New("missing line ending")
+	ErrUnexpectedChecksum   = errors.New("unexpected checksum")
+	ErrUnexpectedLineEnding = errors.New("unexpected line ending")
 )
 
+// maxAddresses is the maximum number of distinct addresses that a Parser
+// interns.
+const maxAddresses = 1024
+
 type InvalidChecksumError struct {
 	Expected byte
 	Got      byte
@@ -48,10 +55,27 @@ func (e InvalidChecksumError) Error() string {
 	return fmt.Sprintf("invalid checksum: expected %02X, got %02X", e.Expected, e.Got)
 }
 
+// A SentenceDecoder is a Sentence that can decode itself from a Tokenizer,
+// reusing any memory that it already holds.
+type SentenceDecoder interface {
+	Sentence
+	Decode(addr string, tok *Tokenizer) error
+}
+
+type UnexpectedAddressError struct {
+	Address string
+}
+
+func (e *UnexpectedAddressError) Error() string {
+	return fmt.Sprintf("%s: unexpected address", e.Address)
+}
+
 type Parser struct {
 	checksumDiscipline   ChecksumDiscipline
 	lineEndingDiscipline LineEndingDiscipline
 	sentenceParserFuncs  []func(string) SentenceParser
+	addressesMutex       sync.RWMutex
+	addresses            map[string]string
 }
 
 type ParserOption func(*Parser)
@@ -87,6 +111,7 @@ func NewParser(options ...ParserOption) *Parser {
 	p := &Parser{
 		checksumDiscipline:   ChecksumDisciplineStrict,
 		lineEndingDiscipline: LineEndingDisciplineStrict,
+		addresses:            make(map[string]string),
 	}
 	for _, option := range options {
 		option(p)
@@ -94,77 +119,162 @@ func NewParser(options ...ParserOption) *Parser {
 	return p
 }
 
+// Parse parses a single sentence from data. The sentence's parser is
+// called with a pooled Tokenizer, so it must not retain it.
 func (p *Parser) Parse(data []byte) (Sentence, error) {
-	m := sentenceRx.FindSubmatch(data)
-	if m == nil {
-		return nil, errFraming
+	tok := tokenizerPool.Get().(*Tokenizer) //nolint:forcetypeassert
+	defer tokenizerPool.Put(tok)
+	address, err := p.tokenize(tok, data)
+	if err != nil {
+		return nil, err
 	}
+	for _, sentenceParserFunc := range p.sentenceParserFuncs {
+		if sentenceParser := sentenceParserFunc(address); sentenceParser != nil {
+			return sentenceParser(address, tok)
+		}
+	}
+	return ParseUnknown(address, tok)
+}
 
-	var checksum Optional[byte]
-	if len(m[2]) != 0 {
-		hexDigit1, _ := hexDigitValue(m[2][0])
-		hexDigit2, _ := hexDigitValue(m[2][1])
-		checksum = NewOptional(16*byte(hexDigit1) + byte(hexDigit2))
+// ParseInto parses a single sentence from data into dst, which allows the
+// caller to reuse dst and avoid allocations. dst returns an
+// *UnexpectedAddressError if data contains a different type of sentence.
+func (p *Parser) ParseInto(dst SentenceDecoder, data []byte) error {
+	tok := tokenizerPool.Get().(*Tokenizer) //nolint:forcetypeassert
+	defer tokenizerPool.Put(tok)
+	address, err := p.tokenize(tok, data)
+	if err != nil {
+		return err
 	}
+	return dst.Decode(address, tok)
+}
+
+func (p *Parser) ParseString(s string) (Sentence, error) {
+	return p.Parse([]byte(s))
+}
+
+// tokenize checks the framing, checksum, and line ending of data, resets tok
+// to the sentence's fields, and returns the sentence's address.
+func (p *Parser) tokenize(tok *Tokenizer, data []byte) (string, error) {
+	body, checksum, lineEnding, ok := frame(data)
+	if !ok {
+		return "", ErrFraming
+	}
+
 	// FIXME add lax checksum checking which returns any checksum error but also the parsed sentence
 	// FIXME add hex check checksum that at least ensures that the checksum contains hex digits
 	var calculatedChecksumValue byte
-	for _, c := range m[1] {
+	for _, c := range body {
 		calculatedChecksumValue ^= c
 	}
 	switch p.checksumDiscipline {
 	case ChecksumDisciplineStrict:
 		switch {
 		case !checksum.Valid:
-			return nil, errMissingChecksum
+			return "", ErrMissingChecksum
 		case checksum.Value != calculatedChecksumValue:
-			return nil, InvalidChecksumError{
+			return "", InvalidChecksumError{
 				Expected: calculatedChecksumValue,
 				Got:      checksum.Value,
 			}
 		}
 	case ChecksumDisciplineRequire:
 		if !checksum.Valid {
-			return nil, errMissingChecksum
+			return "", ErrMissingChecksum
 		}
 	case ChecksumDisciplineIgnore:
 		// Do nothing.
 	case ChecksumDisciplineNever:
 		if checksum.Valid {
-			return nil, errUnexpectedChecksum
+			return "", ErrUnexpectedChecksum
 		}
 	}
 
 	switch p.lineEndingDiscipline {
 	case LineEndingDisciplineStrict:
-		if !bytes.Equal(m[3], []byte{'\r', '\n'}) {
-			return nil, errInvalidLineEnding
+		if string(lineEnding) != "\r\n" {
+			return "", ErrInvalidLineEnding
 		}
 	case LineEndingDisciplineRequire:
-		if len(m[3]) == 0 {
-			return nil, errMissingLineEnding
+		if len(lineEnding) == 0 {
+			return "", ErrMissingLineEnding
 		}
 	case LineEndingDisciplineIgnore:
 		// Do nothing.
 	case LineEndingDisciplineNever:
-		if len(m[3]) != 0 {
-			return nil, errUnexpectedLineEnding
+		if len(lineEnding) != 0 {
+			return "", ErrUnexpectedLineEnding
 		}
 	}
 
-	tokenizer := NewTokenizer(m[1])
-	address := tokenizer.String()
-	if err := tokenizer.Err(); err != nil {
-		return nil, err
+	tok.Reset(body)
+	tok.encapsulated = data[0] == '!'
+	addressBytes := tok.Bytes()
+	if err := tok.Err(); err != nil {
+		return "", err
 	}
-	for _, sentenceParserFunc := range p.sentenceParserFuncs {
-		if sentenceParser := sentenceParserFunc(address); sentenceParser != nil {
-			return sentenceParser(address, tokenizer)
+	tok.address = p.intern(addressBytes)
+	return tok.address, nil
+}
+
+// intern returns addressBytes as a string, allocating only the first time
+// that each address is seen.
+func (p *Parser) intern(addressBytes []byte) string {
+	p.addressesMutex.RLock()
+	address, ok := p.addresses[string(addressBytes)]
+	p.addressesMutex.RUnlock()
+	if ok {
+		return address
+	}
+	address = string(addressBytes)
+	p.addressesMutex.Lock()
+	if len(p.addresses) < maxAddresses {
+		p.addresses[address] = address
+	}
+	p.addressesMutex.Unlock()
+	return address
+}
+
+// frame splits data of the form $body*hh\r\n into its body, checksum, and
+// line ending. The start delimiter can be $ or !. The checksum and line
+// ending are optional, the body must be non-empty and must not contain $ or
+// *, and the line ending can be \r\n or \n.
+func frame(data []byte) (body []byte, checksum Optional[byte], lineEnding []byte, ok bool) {
+	if len(data) < 2 || (data[0] != '$' && data[0] != '!') {
+		return nil, Optional[byte]{}, nil, false
+	}
+	i := 1
+	for i < len(data) && data[i] != '*' {
+		if data[i] == '$' {
+			return nil, Optional[byte]{}, nil, false
 		}
+		i++
+	}
+	if i == 1 || i == len(data) {
+		return nil, Optional[byte]{}, nil, false
+	}
+	body = data[1:i]
+	rest := data[i+1:]
+	if len(rest) >= 2 && rest[0] != '\n' && rest[1] != '\n' && isLineEnding(rest[2:]) {
+		hexDigit1, _ := hexDigitValue(rest[0])
+		hexDigit2, _ := hexDigitValue(rest[1])
+		return body, NewOptional(16*byte(hexDigit1) + byte(hexDigit2)), rest[2:], true
 	}
-	return ParseUnknown(address, tokenizer)
+	if isLineEnding(rest) {
+		return body, Optional[byte]{}, rest, true
+	}
+	return nil, Optional[byte]{}, nil, false
 }
 
-func (p *Parser) ParseString(s string) (Sentence, error) {
-	return p.Parse([]byte(s))
+func isLineEnding(data []byte) bool {
+	switch len(data) {
+	case 0:
+		return true
+	case 1:
+		return data[0] == '\n'
+	case 2:
+		return data[0] == '\r' && data[1] == '\n'
+	default:
+		return false
+	}
 }
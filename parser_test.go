@@ -0,0 +1,205 @@
+package nmea_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+)
+
+func TestParser(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		options     []nmea.ParserOption
+		s           string
+		expectedErr bool
+		expected    nmea.Sentence
+	}{
+		{
+			name: "valid",
+			s:    "$GPTXT,01*62\r\n",
+			expected: &nmea.Unknown{
+				Address: nmea.NewAddress("GPTXT"),
+				Fields:  []string{"01"},
+			},
+		},
+		{
+			name: "encapsulation_start_delimiter",
+			s:    "!GPTXT,01*62\r\n",
+			expected: &nmea.Unknown{
+				Address:      nmea.NewAddress("GPTXT"),
+				Encapsulated: true,
+				Fields:       []string{"01"},
+			},
+		},
+		{
+			name:        "missing_dollar",
+			s:           "GPTXT,01*62\r\n",
+			expectedErr: true,
+		},
+		{
+			name:        "missing_star",
+			s:           "$GPTXT,01\r\n",
+			expectedErr: true,
+		},
+		{
+			name:        "empty_body",
+			s:           "$*00\r\n",
+			expectedErr: true,
+		},
+		{
+			name:        "dollar_in_body",
+			s:           "$GP$TXT,01*62\r\n",
+			expectedErr: true,
+		},
+		{
+			name:        "trailing_data",
+			s:           "$GPTXT,01*62\r\nx",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid_checksum",
+			s:           "$GPTXT,01*63\r\n",
+			expectedErr: true,
+		},
+		{
+			name:        "missing_checksum",
+			s:           "$GPTXT,01*\r\n",
+			expectedErr: true,
+		},
+		{
+			name: "missing_checksum_ignored",
+			options: []nmea.ParserOption{
+				nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineIgnore),
+			},
+			s: "$GPTXT,01*\r\n",
+			expected: &nmea.Unknown{
+				Address: nmea.NewAddress("GPTXT"),
+				Fields:  []string{"01"},
+			},
+		},
+		{
+			name: "newline_line_ending",
+			options: []nmea.ParserOption{
+				nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineRequire),
+			},
+			s: "$GPTXT,01*62\n",
+			expected: &nmea.Unknown{
+				Address: nmea.NewAddress("GPTXT"),
+				Fields:  []string{"01"},
+			},
+		},
+		{
+			name:        "newline_line_ending_strict",
+			s:           "$GPTXT,01*62\n",
+			expectedErr: true,
+		},
+		{
+			name: "no_line_ending",
+			options: []nmea.ParserOption{
+				nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			},
+			s: "$GPTXT,01*62",
+			expected: &nmea.Unknown{
+				Address: nmea.NewAddress("GPTXT"),
+				Fields:  []string{"01"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			parser := nmea.NewParser(tc.options...)
+			actual, err := parser.ParseString(tc.s)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func BenchmarkParser_Parse(b *testing.B) {
+	parser := nmea.NewParser()
+	data := []byte("$GPTXT,01*62\r\n")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := parser.Parse(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestParser_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		options     []nmea.ParserOption
+		s           string
+		expectedErr error
+	}{
+		{
+			name:        "missing_dollar",
+			s:           "GPTXT,01*62\r\n",
+			expectedErr: nmea.ErrFraming,
+		},
+		{
+			name:        "missing_star",
+			s:           "$GPTXT,01\r\n",
+			expectedErr: nmea.ErrFraming,
+		},
+		{
+			name:        "missing_checksum",
+			s:           "$GPTXT,01*\r\n",
+			expectedErr: nmea.ErrMissingChecksum,
+		},
+		{
+			name:        "newline_line_ending_strict",
+			s:           "$GPTXT,01*62\n",
+			expectedErr: nmea.ErrInvalidLineEnding,
+		},
+		{
+			name: "missing_line_ending",
+			options: []nmea.ParserOption{
+				nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineRequire),
+			},
+			s:           "$GPTXT,01*62",
+			expectedErr: nmea.ErrMissingLineEnding,
+		},
+		{
+			name: "unexpected_line_ending",
+			options: []nmea.ParserOption{
+				nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			},
+			s:           "$GPTXT,01*62\r\n",
+			expectedErr: nmea.ErrUnexpectedLineEnding,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := nmea.NewParser(tc.options...).ParseString(tc.s)
+			assert.IsError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestParser_SyntaxError(t *testing.T) {
+	parser := nmea.NewParser(
+		nmea.WithSentenceParserFunc(func(addr string) nmea.SentenceParser {
+			return func(addr string, tok *nmea.Tokenizer) (nmea.Sentence, error) {
+				tok.CommaLatDegMinCommaHemi()
+				tok.EndOfData()
+				return nil, tok.Err()
+			}
+		}),
+	)
+	_, err := parser.ParseString("$GPGLL,4717.11399,X*10\r\n")
+	var syntaxError *nmea.SyntaxError
+	assert.True(t, errors.As(err, &syntaxError))
+	assert.IsError(t, err, nmea.ErrUnexpectedByte)
+	assert.Equal(t, "GPGLL", syntaxError.Address)
+	assert.Equal(t, 2, syntaxError.Field)
+	assert.Equal(t, "X", syntaxError.FieldText)
+	assert.Equal(t, nmea.FieldKindHemisphere, syntaxError.Kind)
+	assert.Equal(t, `GPGLL: field 2 (hemisphere) "X": syntax error at position 17: unexpected byte`, syntaxError.Error())
+}
@@ -0,0 +1,64 @@
+package rtcm3
+
+// A bitReader reads big-endian bit fields from a payload.
+type bitReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *bitReader) bool() bool {
+	return r.uint(1) != 0
+}
+
+func (r *bitReader) int(n int) int64 {
+	value := r.uint(n)
+	if value&(1<<(n-1)) != 0 {
+		return int64(value) - 1<<n
+	}
+	return int64(value)
+}
+
+func (r *bitReader) uint(n int) uint64 {
+	if r.err != nil {
+		return 0
+	}
+	if r.pos+n > 8*len(r.data) {
+		r.err = ErrShortPayload
+		return 0
+	}
+	var value uint64
+	for range n {
+		value = value<<1 | uint64(r.data[r.pos/8]>>(7-r.pos%8)&1)
+		r.pos++
+	}
+	return value
+}
+
+// A bitWriter appends big-endian bit fields to a payload.
+type bitWriter struct {
+	data []byte
+	pos  int
+}
+
+func (w *bitWriter) bool(value bool) {
+	if value {
+		w.uint(1, 1)
+	} else {
+		w.uint(1, 0)
+	}
+}
+
+func (w *bitWriter) int(n int, value int64) {
+	w.uint(n, uint64(value)&(1<<n-1))
+}
+
+func (w *bitWriter) uint(n int, value uint64) {
+	for i := n - 1; i >= 0; i-- {
+		if w.pos%8 == 0 {
+			w.data = append(w.data, 0)
+		}
+		w.data[len(w.data)-1] |= byte(value>>i&1) << (7 - w.pos%8)
+		w.pos++
+	}
+}
@@ -0,0 +1,125 @@
+package rtcm3
+
+import (
+	"errors"
+	"math/bits"
+	"time"
+
+	"github.com/twpayne/go-nmea/standard"
+)
+
+var errTooManyCells = errors.New("too many cells")
+
+// msmBaseMessageTypes maps constellations to the message type of their MSM0,
+// which does not exist, so MSM1 to MSM7 are the following seven message
+// types.
+var msmBaseMessageTypes = map[standard.Constellation]int{
+	standard.ConstellationGPS:     1070,
+	standard.ConstellationGLONASS: 1080,
+	standard.ConstellationGalileo: 1090,
+	standard.ConstellationSBAS:    1100,
+	standard.ConstellationQZSS:    1110,
+	standard.ConstellationBeiDou:  1120,
+	standard.ConstellationNavIC:   1130,
+}
+
+// An MSMHeader is the header of a Multiple Signal Message. Satellites and
+// Signals are the one-based satellite and signal IDs present in the
+// message, and Cells reports, for each satellite in turn, which of its
+// signals are present.
+type MSMHeader struct {
+	Constellation           standard.Constellation
+	MSM                     int
+	StationID               int
+	EpochTime               int
+	MultipleMessage         bool
+	IODS                    int
+	ClockSteering           int
+	ExternalClock           int
+	DivergenceFreeSmoothing bool
+	SmoothingInterval       int
+	Satellites              []int
+	Signals                 []int
+	Cells                   []bool
+}
+
+func DecodeMSMHeader(payload []byte) (*MSMHeader, error) {
+	r := &bitReader{data: payload}
+	var h MSMHeader
+	h.Constellation, h.MSM = msmOf(int(r.uint(12)))
+	h.StationID = int(r.uint(12))
+	h.EpochTime = int(r.uint(30))
+	h.MultipleMessage = r.bool()
+	h.IODS = int(r.uint(3))
+	_ = r.uint(7)
+	h.ClockSteering = int(r.uint(2))
+	h.ExternalClock = int(r.uint(2))
+	h.DivergenceFreeSmoothing = r.bool()
+	h.SmoothingInterval = int(r.uint(3))
+	h.Satellites = maskIDs(r.uint(64), 64)
+	h.Signals = maskIDs(r.uint(32), 32)
+	if r.err != nil {
+		return nil, r.err
+	}
+	numberOfCells := len(h.Satellites) * len(h.Signals)
+	if numberOfCells > 64 {
+		return nil, errTooManyCells
+	}
+	h.Cells = make([]bool, numberOfCells)
+	for i := range h.Cells {
+		h.Cells[i] = r.bool()
+	}
+	return &h, r.err
+}
+
+// Epoch returns h's epoch time as a duration since the start of the week,
+// or, for GLONASS, since the start of the week in Moscow time.
+func (h *MSMHeader) Epoch() time.Duration {
+	if h.Constellation == standard.ConstellationGLONASS {
+		day := h.EpochTime >> 27
+		millisecond := h.EpochTime & (1<<27 - 1)
+		return time.Duration(day)*24*time.Hour + time.Duration(millisecond)*time.Millisecond
+	}
+	return time.Duration(h.EpochTime) * time.Millisecond
+}
+
+func (h *MSMHeader) MessageType() int {
+	return msmBaseMessageTypes[h.Constellation] + h.MSM
+}
+
+// NumberOfCells returns the number of signals present in the message.
+func (h *MSMHeader) NumberOfCells() int {
+	n := 0
+	for _, cell := range h.Cells {
+		if cell {
+			n++
+		}
+	}
+	return n
+}
+
+func isMSM(messageType int) bool {
+	constellation, _ := msmOf(messageType)
+	return constellation != standard.ConstellationUnknown
+}
+
+// maskIDs returns the one-based indexes of the bits set in the n-bit mask,
+// starting from the most significant bit.
+func maskIDs(mask uint64, n int) []int {
+	ids := make([]int, 0, bits.OnesCount64(mask))
+	for i := range n {
+		if mask&(1<<(n-1-i)) != 0 {
+			ids = append(ids, i+1)
+		}
+	}
+	return ids
+}
+
+func msmOf(messageType int) (standard.Constellation, int) {
+	for constellation, baseMessageType := range msmBaseMessageTypes {
+		if msm := messageType - baseMessageType; 1 <= msm && msm <= 7 {
+			return constellation, msm
+		}
+	}
+	return standard.ConstellationUnknown, 0
+}
@@ -0,0 +1,192 @@
+package rtcm3
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// maxSentenceLength is the maximum length of an NMEA sentence returned by a
+// Reader. Longer sentences are split.
+const maxSentenceLength = 1024
+
+// A Packet is either an NMEA sentence, including any line ending, or an
+// RTCM 3 frame.
+type Packet struct {
+	Sentence []byte
+	Frame    *Frame
+}
+
+type MessageTypeStats struct {
+	Frames int
+	Bytes  int
+}
+
+type Stats struct {
+	Sentences      int
+	MessageTypes   map[int]MessageTypeStats
+	CRCErrors      int
+	DiscardedBytes int
+}
+
+// A Reader splits a byte stream into NMEA sentences and RTCM 3 frames.
+// Frames with invalid CRCs and bytes that are neither part of a sentence
+// nor part of a frame are discarded.
+type Reader struct {
+	br    *bufio.Reader
+	stats Stats
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		br: bufio.NewReader(r),
+		stats: Stats{
+			MessageTypes: make(map[int]MessageTypeStats),
+		},
+	}
+}
+
+// All returns an iterator over the packets in r. Iteration stops at the end
+// of the stream or after the first read error, which is yielded.
+func (r *Reader) All() iter.Seq2[Packet, error] {
+	return func(yield func(Packet, error) bool) {
+		for {
+			packet, err := r.Next()
+			switch {
+			case errors.Is(err, io.EOF):
+				return
+			case err != nil:
+				yield(Packet{}, err)
+				return
+			case !yield(packet, nil):
+				return
+			}
+		}
+	}
+}
+
+// Next returns the next packet from r. It returns io.EOF at the end of the
+// stream.
+func (r *Reader) Next() (Packet, error) {
+	for {
+		data, err := r.br.Peek(1)
+		if len(data) == 0 {
+			return Packet{}, err
+		}
+		switch data[0] {
+		case preamble:
+			switch frame, err := r.readFrame(); {
+			case err != nil:
+				return Packet{}, err
+			case frame != nil:
+				return Packet{Frame: frame}, nil
+			}
+		case '$', '!':
+			sentence, err := r.readSentence()
+			if err != nil {
+				return Packet{}, err
+			}
+			return Packet{Sentence: sentence}, nil
+		default:
+			r.discard(1)
+		}
+	}
+}
+
+// Stats returns statistics about the packets read from r so far.
+func (r *Reader) Stats() Stats {
+	stats := r.stats
+	stats.MessageTypes = maps.Clone(r.stats.MessageTypes)
+	return stats
+}
+
+func (r *Reader) discard(n int) {
+	n, _ = r.br.Discard(n)
+	r.stats.DiscardedBytes += n
+}
+
+// peek is like bufio.Reader.Peek except that it returns a nil error, and
+// possibly fewer than n bytes, at the end of the stream.
+func (r *Reader) peek(n int) ([]byte, error) {
+	data, err := r.br.Peek(n)
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+	return data, err
+}
+
+// readFrame reads a frame. If the data at the start of the buffer is not a
+// valid frame then it discards the preamble and returns nil.
+func (r *Reader) readFrame() (*Frame, error) {
+	header, err := r.peek(headerLength)
+	if err != nil {
+		return nil, err
+	}
+	if len(header) < headerLength || header[1]&0xfc != 0 {
+		r.discard(1)
+		return nil, nil
+	}
+	n := headerLength + (int(header[1])<<8 | int(header[2])) + crcLength
+	data, err := r.peek(n)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < n {
+		r.discard(1)
+		return nil, nil
+	}
+	crc := uint32(data[n-3])<<16 | uint32(data[n-2])<<8 | uint32(data[n-1])
+	if crc24q(data[:n-crcLength]) != crc {
+		r.stats.CRCErrors++
+		r.discard(1)
+		return nil, nil
+	}
+	frame := &Frame{
+		Data: slices.Clone(data),
+	}
+	_, _ = r.br.Discard(n)
+	messageType := frame.MessageType()
+	messageTypeStats := r.stats.MessageTypes[messageType]
+	messageTypeStats.Frames++
+	messageTypeStats.Bytes += n
+	r.stats.MessageTypes[messageType] = messageTypeStats
+	return frame, nil
+}
+
+// readSentence reads a sentence up to and including the next newline, or
+// up to but excluding the start of the next sentence or frame.
+func (r *Reader) readSentence() ([]byte, error) {
+	n, err := r.sentenceLength()
+	if err != nil {
+		return nil, err
+	}
+	data, _ := r.peek(n)
+	sentence := slices.Clone(data)
+	_, _ = r.br.Discard(n)
+	r.stats.Sentences++
+	return sentence, nil
+}
+
+// sentenceLength returns the length of the sentence at the start of the
+// buffer.
+func (r *Reader) sentenceLength() (int, error) {
+	for n := 1; n < maxSentenceLength; n++ {
+		data, err := r.peek(n + 1)
+		switch {
+		case err != nil:
+			return 0, err
+		case len(data) == n:
+			return n, nil
+		}
+		switch data[n] {
+		case '\n':
+			return n + 1, nil
+		case preamble, '$', '!':
+			return n, nil
+		}
+	}
+	return maxSentenceLength, nil
+}
@@ -0,0 +1,102 @@
+// Package rtcm3 reads RTCM 3 frames interleaved with NMEA sentences and
+// decodes the messages used to monitor RTK base stations.
+//
+// An RTCM 3 frame consists of the preamble 0xD3, six reserved bits, a
+// ten-bit payload length, the payload, and a CRC-24Q checksum of everything
+// before it.
+package rtcm3
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	preamble         = 0xd3
+	headerLength     = 3
+	crcLength        = 3
+	MaxPayloadLength = 1023
+)
+
+var (
+	ErrPayloadTooLong = errors.New("payload too long")
+	ErrShortPayload   = errors.New("short payload")
+)
+
+type UnknownMessageTypeError struct {
+	MessageType int
+}
+
+func (e UnknownMessageTypeError) Error() string {
+	return fmt.Sprintf("%d: unknown message type", e.MessageType)
+}
+
+// A Message is a decoded RTCM 3 message.
+type Message interface {
+	MessageType() int
+}
+
+// A Frame is an RTCM 3 frame with a valid CRC.
+type Frame struct {
+	Data []byte
+}
+
+// NewFrame returns a new Frame containing payload.
+func NewFrame(payload []byte) (*Frame, error) {
+	if len(payload) > MaxPayloadLength {
+		return nil, ErrPayloadTooLong
+	}
+	data := make([]byte, 0, headerLength+len(payload)+crcLength)
+	data = append(data, preamble, byte(len(payload)>>8), byte(len(payload)))
+	data = append(data, payload...)
+	crc := crc24q(data)
+	data = append(data, byte(crc>>16), byte(crc>>8), byte(crc))
+	return &Frame{
+		Data: data,
+	}, nil
+}
+
+// Decode decodes f's payload.
+func (f *Frame) Decode() (Message, error) {
+	payload := f.Payload()
+	switch messageType := f.MessageType(); {
+	case messageType == 1005 || messageType == 1006:
+		return DecodeStationaryReferenceStation(payload)
+	case isMSM(messageType):
+		return DecodeMSMHeader(payload)
+	default:
+		return nil, UnknownMessageTypeError{
+			MessageType: messageType,
+		}
+	}
+}
+
+// MessageType returns f's message type, or zero if f's payload is too short
+// to contain one.
+func (f *Frame) MessageType() int {
+	payload := f.Payload()
+	if len(payload) < 2 {
+		return 0
+	}
+	return int(payload[0])<<4 | int(payload[1])>>4
+}
+
+// Payload returns f's payload.
+func (f *Frame) Payload() []byte {
+	return f.Data[headerLength : len(f.Data)-crcLength]
+}
+
+// crc24q returns the CRC-24Q checksum of data.
+func crc24q(data []byte) uint32 {
+	var crc uint32
+	for _, b := range data {
+		crc ^= uint32(b) << 16
+		for range 8 {
+			crc <<= 1
+			if crc&0x1000000 != 0 {
+				crc ^= 0x1864cfb
+			}
+		}
+	}
+	return crc & 0xffffff
+}
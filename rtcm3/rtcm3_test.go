@@ -0,0 +1,185 @@
+package rtcm3_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/rtcm3"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+// frame1005 is the example message 1005 from the RTCM 3 standard.
+const frame1005 = "D300133ED7D30202980EDEEF34B4BD62AC0941986F33360B98"
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	data, err := hex.DecodeString(s)
+	assert.NoError(t, err)
+	return data
+}
+
+// appendBits appends the n-bit value to the bit string bits.
+func appendBits(bits []bool, n int, value uint64) []bool {
+	for i := n - 1; i >= 0; i-- {
+		bits = append(bits, value>>i&1 != 0)
+	}
+	return bits
+}
+
+// packBits packs bits into bytes, most significant bit first.
+func packBits(bits []bool) []byte {
+	data := make([]byte, (len(bits)+7)/8)
+	for i, bit := range bits {
+		if bit {
+			data[i/8] |= 1 << (7 - i%8)
+		}
+	}
+	return data
+}
+
+func TestDecodeStationaryReferenceStation(t *testing.T) {
+	frame := &rtcm3.Frame{
+		Data: mustDecodeHex(t, frame1005),
+	}
+	assert.Equal(t, 1005, frame.MessageType())
+	message, err := frame.Decode()
+	assert.NoError(t, err)
+	expected := &rtcm3.StationaryReferenceStation{
+		StationID: 2003,
+		GPS:       true,
+		ECEFX:     1114104.5999,
+		ECEFY:     -4850729.7108,
+		ECEFZ:     3975521.4643,
+	}
+	assert.Equal[rtcm3.Message](t, expected, message)
+
+	actualFrame, err := rtcm3.NewFrame(expected.AppendPayload(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, frame, actualFrame)
+}
+
+func TestStationaryReferenceStation1006(t *testing.T) {
+	expected := &rtcm3.StationaryReferenceStation{
+		StationID:           4095,
+		ITRFRealizationYear: 14,
+		GPS:                 true,
+		GLONASS:             true,
+		Galileo:             true,
+		ECEFX:               4331297.3480,
+		ECEFY:               567555.6390,
+		ECEFZ:               4633133.7870,
+		AntennaHeight:       nmea.NewOptional(1.5432),
+	}
+	assert.Equal(t, 1006, expected.MessageType())
+	frame, err := rtcm3.NewFrame(expected.AppendPayload(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, 1006, frame.MessageType())
+	actual, err := frame.Decode()
+	assert.NoError(t, err)
+	assert.Equal[rtcm3.Message](t, expected, actual)
+}
+
+func TestDecodeMSMHeader(t *testing.T) {
+	var bits []bool
+	bits = appendBits(bits, 12, 1084)
+	bits = appendBits(bits, 12, 17)
+	bits = appendBits(bits, 3, 2)
+	bits = appendBits(bits, 27, 3600000)
+	bits = appendBits(bits, 1, 1)
+	bits = appendBits(bits, 3, 5)
+	bits = appendBits(bits, 7, 0)
+	bits = appendBits(bits, 2, 1)
+	bits = appendBits(bits, 2, 2)
+	bits = appendBits(bits, 1, 0)
+	bits = appendBits(bits, 3, 0)
+	bits = appendBits(bits, 64, 1<<63|1<<60|1<<40)
+	bits = appendBits(bits, 32, 1<<30|1<<23)
+	bits = appendBits(bits, 6, 0b110111)
+	frame, err := rtcm3.NewFrame(packBits(bits))
+	assert.NoError(t, err)
+
+	message, err := frame.Decode()
+	assert.NoError(t, err)
+	header, ok := message.(*rtcm3.MSMHeader)
+	assert.True(t, ok)
+	assert.Equal(t, &rtcm3.MSMHeader{
+		Constellation:   standard.ConstellationGLONASS,
+		MSM:             4,
+		StationID:       17,
+		EpochTime:       2<<27 | 3600000,
+		MultipleMessage: true,
+		IODS:            5,
+		ClockSteering:   1,
+		ExternalClock:   2,
+		Satellites:      []int{1, 4, 24},
+		Signals:         []int{2, 9},
+		Cells:           []bool{true, true, false, true, true, true},
+	}, header)
+	assert.Equal(t, 1084, header.MessageType())
+	assert.Equal(t, 49*time.Hour, header.Epoch())
+	assert.Equal(t, 5, header.NumberOfCells())
+}
+
+func TestFrameDecodeErrors(t *testing.T) {
+	frame, err := rtcm3.NewFrame([]byte{0x3f, 0x20})
+	assert.NoError(t, err)
+	_, err = frame.Decode()
+	assert.Equal[error](t, rtcm3.UnknownMessageTypeError{MessageType: 1010}, err)
+
+	frame, err = rtcm3.NewFrame([]byte{0x3e, 0xd0, 0x00})
+	assert.NoError(t, err)
+	_, err = frame.Decode()
+	assert.IsError(t, err, rtcm3.ErrShortPayload)
+
+	_, err = rtcm3.NewFrame(make([]byte, rtcm3.MaxPayloadLength+1))
+	assert.IsError(t, err, rtcm3.ErrPayloadTooLong)
+}
+
+func TestReader(t *testing.T) {
+	frame := mustDecodeHex(t, frame1005)
+	badFrame := bytes.Clone(frame)
+	badFrame[len(badFrame)-1] ^= 0xff
+	var stream []byte
+	stream = append(stream, "$GPTXT,01*62\r\n"...)
+	stream = append(stream, frame...)
+	stream = append(stream, "garbage"...)
+	stream = append(stream, badFrame...)
+	stream = append(stream, "$GPTXT,01*62"...)
+	stream = append(stream, frame...)
+	stream = append(stream, "!GPTXT,01*62\r\n"...)
+	stream = append(stream, frame[:10]...)
+
+	reader := rtcm3.NewReader(bytes.NewReader(stream))
+	var packets []rtcm3.Packet
+	for packet, err := range reader.All() {
+		assert.NoError(t, err)
+		packets = append(packets, packet)
+	}
+	assert.Equal(t, []rtcm3.Packet{
+		{Sentence: []byte("$GPTXT,01*62\r\n")},
+		{Frame: &rtcm3.Frame{Data: frame}},
+		{Sentence: []byte("$GPTXT,01*62")},
+		{Frame: &rtcm3.Frame{Data: frame}},
+		{Sentence: []byte("!GPTXT,01*62\r\n")},
+	}, packets)
+	assert.Equal(t, rtcm3.Stats{
+		Sentences: 3,
+		MessageTypes: map[int]rtcm3.MessageTypeStats{
+			1005: {
+				Frames: 2,
+				Bytes:  2 * len(frame),
+			},
+		},
+		CRCErrors:      1,
+		DiscardedBytes: len("garbage") + len(badFrame) + 10,
+	}, reader.Stats())
+
+	parser := nmea.NewParser(nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineIgnore))
+	_, err := parser.Parse(packets[0].Sentence)
+	assert.NoError(t, err)
+}
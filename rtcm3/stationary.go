@@ -0,0 +1,77 @@
+package rtcm3
+
+import (
+	"math"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// A StationaryReferenceStation is a message 1005, stationary RTK reference
+// station antenna reference point, or, if AntennaHeight is valid, a message
+// 1006, which also includes the antenna height. Coordinates and heights are
+// in meters.
+type StationaryReferenceStation struct {
+	StationID                int
+	ITRFRealizationYear      int
+	GPS                      bool
+	GLONASS                  bool
+	Galileo                  bool
+	NonPhysical              bool
+	ECEFX                    float64
+	SingleReceiverOscillator bool
+	ECEFY                    float64
+	QuarterCycleIndicator    int
+	ECEFZ                    float64
+	AntennaHeight            nmea.Optional[float64]
+}
+
+func DecodeStationaryReferenceStation(payload []byte) (*StationaryReferenceStation, error) {
+	r := &bitReader{data: payload}
+	var s StationaryReferenceStation
+	messageType := r.uint(12)
+	s.StationID = int(r.uint(12))
+	s.ITRFRealizationYear = int(r.uint(6))
+	s.GPS = r.bool()
+	s.GLONASS = r.bool()
+	s.Galileo = r.bool()
+	s.NonPhysical = r.bool()
+	s.ECEFX = float64(r.int(38)) / 1e4
+	s.SingleReceiverOscillator = r.bool()
+	_ = r.uint(1)
+	s.ECEFY = float64(r.int(38)) / 1e4
+	s.QuarterCycleIndicator = int(r.uint(2))
+	s.ECEFZ = float64(r.int(38)) / 1e4
+	if messageType == 1006 {
+		s.AntennaHeight = nmea.NewOptional(float64(r.uint(16)) / 1e4)
+	}
+	return &s, r.err
+}
+
+// AppendPayload appends s's payload to data.
+func (s *StationaryReferenceStation) AppendPayload(data []byte) []byte {
+	w := &bitWriter{data: data, pos: 8 * len(data)}
+	w.uint(12, uint64(s.MessageType()))
+	w.uint(12, uint64(s.StationID))
+	w.uint(6, uint64(s.ITRFRealizationYear))
+	w.bool(s.GPS)
+	w.bool(s.GLONASS)
+	w.bool(s.Galileo)
+	w.bool(s.NonPhysical)
+	w.int(38, int64(math.Round(1e4*s.ECEFX)))
+	w.bool(s.SingleReceiverOscillator)
+	w.uint(1, 0)
+	w.int(38, int64(math.Round(1e4*s.ECEFY)))
+	w.uint(2, uint64(s.QuarterCycleIndicator))
+	w.int(38, int64(math.Round(1e4*s.ECEFZ)))
+	if s.AntennaHeight.Valid {
+		w.uint(16, uint64(math.Round(1e4*s.AntennaHeight.Value)))
+	}
+	return w.data
+}
+
+func (s *StationaryReferenceStation) MessageType() int {
+	if s.AntennaHeight.Valid {
+		return 1006
+	}
+	return 1005
+}
@@ -0,0 +1,62 @@
+package septentrio
+
+import "github.com/twpayne/go-nmea"
+
+// Attitude modes.
+const (
+	AttitudeModeNone                  = 0
+	AttitudeModeHeadingPitchFloat     = 1
+	AttitudeModeHeadingPitchFixed     = 2
+	AttitudeModeHeadingPitchRollFloat = 3
+	AttitudeModeHeadingPitchRollFixed = 4
+)
+
+// An HRP is a heading, roll, and pitch sentence from a multi-antenna
+// receiver. Angles and their standard deviations are in degrees.
+type HRP struct {
+	nmea.Address
+	TimeOfDay          nmea.TimeOfDay
+	Date               nmea.Date
+	Heading            nmea.Optional[float64]
+	Roll               nmea.Optional[float64]
+	Pitch              nmea.Optional[float64]
+	HeadingStdDev      nmea.Optional[float64]
+	RollStdDev         nmea.Optional[float64]
+	PitchStdDev        nmea.Optional[float64]
+	NumberOfSatellites nmea.Optional[int]
+	AttitudeMode       int
+	MagneticVariation  nmea.Optional[float64]
+}
+
+func ParseHRP(addr string, tok *nmea.Tokenizer) (*HRP, error) {
+	var h HRP
+	h.Address = nmea.NewAddress(addr)
+	h.TimeOfDay = tok.CommaTimeOfDay()
+	h.Date = tok.CommaDate()
+	h.Heading = tok.CommaOptionalUnsignedFloat()
+	h.Roll = tok.CommaOptionalFloat()
+	h.Pitch = tok.CommaOptionalFloat()
+	h.HeadingStdDev = tok.CommaOptionalUnsignedFloat()
+	h.RollStdDev = tok.CommaOptionalUnsignedFloat()
+	h.PitchStdDev = tok.CommaOptionalUnsignedFloat()
+	h.NumberOfSatellites = tok.CommaOptionalUnsignedInt()
+	h.AttitudeMode = tok.CommaUnsignedInt()
+	h.MagneticVariation = commaOptionalMagneticVariation(tok)
+	tok.EndOfData()
+	return &h, tok.Err()
+}
+
+func (h *HRP) Encode(b *nmea.Builder) {
+	b.CommaString("HRP")
+	b.CommaTimeOfDay(h.TimeOfDay, 2)
+	b.CommaDate(h.Date)
+	b.CommaOptionalFloat(h.Heading, 3)
+	b.CommaOptionalFloat(h.Roll, 3)
+	b.CommaOptionalFloat(h.Pitch, 3)
+	b.CommaOptionalFloat(h.HeadingStdDev, 3)
+	b.CommaOptionalFloat(h.RollStdDev, 3)
+	b.CommaOptionalFloat(h.PitchStdDev, 3)
+	b.CommaOptionalInt(h.NumberOfSatellites)
+	b.CommaInt(h.AttitudeMode)
+	encodeCommaOptionalMagneticVariation(b, h.MagneticVariation)
+}
@@ -0,0 +1,49 @@
+package septentrio
+
+import "github.com/twpayne/go-nmea"
+
+// An RBD is a rover-base direction sentence, which gives the direction from
+// the base station to the rover. Angles and their standard deviations are in
+// degrees and the age of corrections is in seconds.
+type RBD struct {
+	nmea.Address
+	TimeOfDay       nmea.TimeOfDay
+	Date            nmea.Date
+	Azimuth         nmea.Optional[float64]
+	Elevation       nmea.Optional[float64]
+	AzimuthStdDev   nmea.Optional[float64]
+	ElevationStdDev nmea.Optional[float64]
+	BaseStationID   string
+	CorrectionAge   nmea.Optional[float64]
+}
+
+func ParseRBD(addr string, tok *nmea.Tokenizer) (*RBD, error) {
+	var r RBD
+	r.Address = nmea.NewAddress(addr)
+	r.TimeOfDay = tok.CommaTimeOfDay()
+	r.Date = tok.CommaDate()
+	r.Azimuth = tok.CommaOptionalUnsignedFloat()
+	r.Elevation = tok.CommaOptionalFloat()
+	_ = tok.CommaString() // Reserved.
+	r.AzimuthStdDev = tok.CommaOptionalUnsignedFloat()
+	r.ElevationStdDev = tok.CommaOptionalUnsignedFloat()
+	_ = tok.CommaString() // Reserved.
+	r.BaseStationID = tok.CommaString()
+	r.CorrectionAge = tok.CommaOptionalUnsignedFloat()
+	tok.EndOfData()
+	return &r, tok.Err()
+}
+
+func (r *RBD) Encode(b *nmea.Builder) {
+	b.CommaString("RBD")
+	b.CommaTimeOfDay(r.TimeOfDay, 2)
+	b.CommaDate(r.Date)
+	b.CommaOptionalFloat(r.Azimuth, 3)
+	b.CommaOptionalFloat(r.Elevation, 3)
+	b.Comma()
+	b.CommaOptionalFloat(r.AzimuthStdDev, 3)
+	b.CommaOptionalFloat(r.ElevationStdDev, 3)
+	b.Comma()
+	b.CommaString(r.BaseStationID)
+	b.CommaOptionalFloat(r.CorrectionAge, 1)
+}
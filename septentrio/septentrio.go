@@ -0,0 +1,70 @@
+// Package septentrio parses and encodes Septentrio PSSN NMEA sentences, as
+// described in the reference guides of Septentrio receivers.
+package septentrio
+
+import (
+	"math"
+
+	"github.com/twpayne/go-nmea"
+)
+
+var sentenceParserMap = map[string]nmea.SentenceParser{
+	"HRP": nmea.MakeSentenceParser(ParseHRP),
+	"RBD": nmea.MakeSentenceParser(ParseRBD),
+	"SNC": nmea.MakeSentenceParser(ParseSNC),
+	"TFM": nmea.MakeSentenceParser(ParseTFM),
+}
+
+type UnknownMessageTypeError struct {
+	MessageType string
+}
+
+func (e *UnknownMessageTypeError) Error() string {
+	return e.MessageType + ": unknown message type"
+}
+
+func ParseSentence(addr string, tok *nmea.Tokenizer) (nmea.Sentence, error) {
+	messageType := tok.CommaString()
+	if err := tok.Err(); err != nil {
+		return nil, err
+	}
+	sentenceParser := sentenceParserMap[messageType]
+	if sentenceParser != nil {
+		return sentenceParser(addr, tok)
+	}
+	return nil, &UnknownMessageTypeError{
+		MessageType: messageType,
+	}
+}
+
+func SentenceParserFunc(addr string) nmea.SentenceParser {
+	if addr != "PSSN" {
+		return nil
+	}
+	return ParseSentence
+}
+
+// commaOptionalMagneticVariation parses a comma followed by an optional
+// magnetic variation, followed by a comma and an optional direction.
+// Westerly variations are negative.
+func commaOptionalMagneticVariation(tok *nmea.Tokenizer) nmea.Optional[float64] {
+	variation := tok.CommaOptionalUnsignedFloat()
+	if tok.CommaOptionalOneByteOf("EW") == nmea.NewOptional[byte]('W') {
+		variation.Value = -variation.Value
+	}
+	return variation
+}
+
+func encodeCommaOptionalMagneticVariation(b *nmea.Builder, variation nmea.Optional[float64]) {
+	if !variation.Valid {
+		b.Comma()
+		b.Comma()
+		return
+	}
+	b.CommaFloat(math.Abs(variation.Value), 3)
+	if variation.Value < 0 {
+		b.CommaByte('W')
+	} else {
+		b.CommaByte('E')
+	}
+}
@@ -0,0 +1,82 @@
+package septentrio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/nmeatest"
+	"github.com/twpayne/go-nmea/septentrio"
+)
+
+func TestSentenceParserFunc(t *testing.T) {
+	timeOfDay := nmea.TimeOfDay{
+		Hour:       14,
+		Minute:     26,
+		Second:     57,
+		Nanosecond: 800000000,
+	}
+	date := nmea.Date{
+		Year:  2022,
+		Month: time.December,
+		Day:   6,
+	}
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(septentrio.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$PSSN,HRP,142657.80,061222,284.932,,-0.699,0.091,,0.181,14,2,2.392,E*23",
+				Expected: &septentrio.HRP{
+					Address:            nmea.NewAddress("PSSN"),
+					TimeOfDay:          timeOfDay,
+					Date:               date,
+					Heading:            nmea.NewOptional(284.932),
+					Pitch:              nmea.NewOptional(-0.699),
+					HeadingStdDev:      nmea.NewOptional(0.091),
+					PitchStdDev:        nmea.NewOptional(0.181),
+					NumberOfSatellites: nmea.NewOptional(14),
+					AttitudeMode:       septentrio.AttitudeModeHeadingPitchFixed,
+					MagneticVariation:  nmea.NewOptional(2.392),
+				},
+			},
+			{
+				S: "$PSSN,RBD,142657.80,061222,45.123,-1.234,,0.012,0.034,,0001,1.2*76",
+				Expected: &septentrio.RBD{
+					Address:         nmea.NewAddress("PSSN"),
+					TimeOfDay:       timeOfDay,
+					Date:            date,
+					Azimuth:         nmea.NewOptional(45.123),
+					Elevation:       nmea.NewOptional(-1.234),
+					AzimuthStdDev:   nmea.NewOptional(0.012),
+					ElevationStdDev: nmea.NewOptional(0.034),
+					BaseStationID:   "0001",
+					CorrectionAge:   nmea.NewOptional(1.2),
+				},
+			},
+			{
+				S: "$PSSN,SNC,142657.80,061222,NTR1,2,,CONNECTED*58",
+				Expected: &septentrio.SNC{
+					Address:    nmea.NewAddress("PSSN"),
+					TimeOfDay:  timeOfDay,
+					Date:       date,
+					Connection: "NTR1",
+					Status:     septentrio.NTRIPStatusConnected,
+					Info:       "CONNECTED",
+				},
+			},
+			{
+				S: "$PSSN,TFM,142657.80,061222,1,1021,1023*52",
+				Expected: &septentrio.TFM{
+					Address:      nmea.NewAddress("PSSN"),
+					TimeOfDay:    timeOfDay,
+					Date:         date,
+					Usage:        septentrio.TransformationUsageUsed,
+					MessageTypes: []int{1021, 1023},
+				},
+			},
+		})
+}
@@ -0,0 +1,47 @@
+package septentrio
+
+import "github.com/twpayne/go-nmea"
+
+// NTRIP client statuses.
+const (
+	NTRIPStatusIdle       = 0
+	NTRIPStatusConnecting = 1
+	NTRIPStatusConnected  = 2
+	NTRIPStatusError      = 3
+)
+
+// An SNC is an NTRIP client status sentence for one of the receiver's NTRIP
+// connections, for example NTR1. ErrorCode is only meaningful when Status is
+// NTRIPStatusError.
+type SNC struct {
+	nmea.Address
+	TimeOfDay  nmea.TimeOfDay
+	Date       nmea.Date
+	Connection string
+	Status     int
+	ErrorCode  nmea.Optional[int]
+	Info       string
+}
+
+func ParseSNC(addr string, tok *nmea.Tokenizer) (*SNC, error) {
+	var s SNC
+	s.Address = nmea.NewAddress(addr)
+	s.TimeOfDay = tok.CommaTimeOfDay()
+	s.Date = tok.CommaDate()
+	s.Connection = tok.CommaString()
+	s.Status = tok.CommaUnsignedInt()
+	s.ErrorCode = tok.CommaOptionalUnsignedInt()
+	s.Info = tok.CommaString()
+	tok.EndOfData()
+	return &s, tok.Err()
+}
+
+func (s *SNC) Encode(b *nmea.Builder) {
+	b.CommaString("SNC")
+	b.CommaTimeOfDay(s.TimeOfDay, 2)
+	b.CommaDate(s.Date)
+	b.CommaString(s.Connection)
+	b.CommaInt(s.Status)
+	b.CommaOptionalInt(s.ErrorCode)
+	b.CommaString(s.Info)
+}
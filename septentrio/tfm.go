@@ -0,0 +1,43 @@
+package septentrio
+
+import "github.com/twpayne/go-nmea"
+
+// RTCM coordinate transformation usages.
+const (
+	TransformationUsageNone = 0
+	TransformationUsageUsed = 1
+)
+
+// A TFM is a coordinate transformation sentence, which reports whether the
+// receiver is using the transformation received in RTCM messages and the
+// types of the RTCM messages that it is using.
+type TFM struct {
+	nmea.Address
+	TimeOfDay    nmea.TimeOfDay
+	Date         nmea.Date
+	Usage        int
+	MessageTypes []int
+}
+
+func ParseTFM(addr string, tok *nmea.Tokenizer) (*TFM, error) {
+	var t TFM
+	t.Address = nmea.NewAddress(addr)
+	t.TimeOfDay = tok.CommaTimeOfDay()
+	t.Date = tok.CommaDate()
+	t.Usage = tok.CommaUnsignedInt()
+	for !tok.AtEndOfData() {
+		t.MessageTypes = append(t.MessageTypes, tok.CommaUnsignedInt())
+	}
+	tok.EndOfData()
+	return &t, tok.Err()
+}
+
+func (t *TFM) Encode(b *nmea.Builder) {
+	b.CommaString("TFM")
+	b.CommaTimeOfDay(t.TimeOfDay, 2)
+	b.CommaDate(t.Date)
+	b.CommaInt(t.Usage)
+	for _, messageType := range t.MessageTypes {
+		b.CommaInt(messageType)
+	}
+}
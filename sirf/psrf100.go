@@ -0,0 +1,47 @@
+package sirf
+
+import "github.com/twpayne/go-nmea"
+
+// PSRF100 protocols.
+const (
+	ProtocolSiRFBinary = 0
+	ProtocolNMEA       = 1
+)
+
+// PSRF100 parities.
+const (
+	ParityNone = 0
+	ParityOdd  = 1
+	ParityEven = 2
+)
+
+// A PSRF100 is a set serial port command, which sets the protocol and
+// serial port parameters of the receiver.
+type PSRF100 struct {
+	nmea.Address
+	Protocol int
+	BaudRate int
+	DataBits int
+	StopBits int
+	Parity   int
+}
+
+func ParsePSRF100(addr string, tok *nmea.Tokenizer) (*PSRF100, error) {
+	var p PSRF100
+	p.Address = nmea.NewAddress(addr)
+	p.Protocol = tok.CommaUnsignedInt()
+	p.BaudRate = tok.CommaUnsignedInt()
+	p.DataBits = tok.CommaUnsignedInt()
+	p.StopBits = tok.CommaUnsignedInt()
+	p.Parity = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PSRF100) Encode(b *nmea.Builder) {
+	b.CommaInt(p.Protocol)
+	b.CommaInt(p.BaudRate)
+	b.CommaInt(p.DataBits)
+	b.CommaInt(p.StopBits)
+	b.CommaInt(p.Parity)
+}
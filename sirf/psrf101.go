@@ -0,0 +1,51 @@
+package sirf
+
+import "github.com/twpayne/go-nmea"
+
+// Reset configuration bits, as used in PSRF101 and PSRF104 sentences.
+const (
+	ResetConfigDataValid      = 1 << 0
+	ResetConfigClearEphemeris = 1 << 1
+	ResetConfigClearMemory    = 1 << 2
+	ResetConfigFactoryReset   = 1 << 3
+)
+
+// A PSRF101 is a navigation initialization command, which restarts the
+// receiver with an initial ECEF position, clock offset, and GPS time.
+type PSRF101 struct {
+	nmea.Address
+	X            int
+	Y            int
+	Z            int
+	ClockOffset  int
+	TimeOfWeek   int
+	WeekNumber   int
+	ChannelCount int
+	ResetConfig  int
+}
+
+func ParsePSRF101(addr string, tok *nmea.Tokenizer) (*PSRF101, error) {
+	var p PSRF101
+	p.Address = nmea.NewAddress(addr)
+	p.X = tok.CommaInt()
+	p.Y = tok.CommaInt()
+	p.Z = tok.CommaInt()
+	p.ClockOffset = tok.CommaUnsignedInt()
+	p.TimeOfWeek = tok.CommaUnsignedInt()
+	p.WeekNumber = tok.CommaUnsignedInt()
+	p.ChannelCount = tok.CommaUnsignedInt()
+	p.ResetConfig = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PSRF101) Encode(b *nmea.Builder) {
+	b.CommaInt(p.X)
+	b.CommaInt(p.Y)
+	b.CommaInt(p.Z)
+	b.CommaInt(p.ClockOffset)
+	b.CommaInt(p.TimeOfWeek)
+	b.CommaInt(p.WeekNumber)
+	b.CommaInt(p.ChannelCount)
+	b.CommaInt(p.ResetConfig)
+}
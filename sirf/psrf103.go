@@ -0,0 +1,56 @@
+package sirf
+
+import "github.com/twpayne/go-nmea"
+
+// PSRF103 messages.
+const (
+	MessageGGA = 0
+	MessageGLL = 1
+	MessageGSA = 2
+	MessageGSV = 3
+	MessageRMC = 4
+	MessageVTG = 5
+	MessageMSS = 6
+	MessageZDA = 8
+)
+
+// PSRF103 modes.
+const (
+	ModeSetRate = 0
+	ModeQuery   = 1
+	ModeABPOn   = 2
+	ModeABPOff  = 3
+)
+
+// A PSRF103 is a query/rate control command, which queries a message once
+// or sets the rate, in seconds, at which it is output. A rate of zero
+// disables the message.
+type PSRF103 struct {
+	nmea.Address
+	Message        int
+	Mode           int
+	Rate           int
+	ChecksumEnable bool
+}
+
+func ParsePSRF103(addr string, tok *nmea.Tokenizer) (*PSRF103, error) {
+	var p PSRF103
+	p.Address = nmea.NewAddress(addr)
+	p.Message = tok.CommaUnsignedInt()
+	p.Mode = tok.CommaUnsignedInt()
+	p.Rate = tok.CommaUnsignedInt()
+	p.ChecksumEnable = tok.CommaUnsignedInt() != 0
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PSRF103) Encode(b *nmea.Builder) {
+	b.CommaZeroPaddedInt(p.Message, 2)
+	b.CommaZeroPaddedInt(p.Mode, 2)
+	b.CommaZeroPaddedInt(p.Rate, 2)
+	if p.ChecksumEnable {
+		b.CommaZeroPaddedInt(1, 2)
+	} else {
+		b.CommaZeroPaddedInt(0, 2)
+	}
+}
@@ -0,0 +1,44 @@
+package sirf
+
+import "github.com/twpayne/go-nmea"
+
+// A PSRF104 is an LLA navigation initialization command, which restarts the
+// receiver with an initial position, clock offset, and GPS time. Lat and Lon
+// are in decimal degrees and Alt is in meters above the ellipsoid.
+type PSRF104 struct {
+	nmea.Address
+	Lat          float64
+	Lon          float64
+	Alt          float64
+	ClockOffset  int
+	TimeOfWeek   int
+	WeekNumber   int
+	ChannelCount int
+	ResetConfig  int
+}
+
+func ParsePSRF104(addr string, tok *nmea.Tokenizer) (*PSRF104, error) {
+	var p PSRF104
+	p.Address = nmea.NewAddress(addr)
+	p.Lat = tok.CommaFloat()
+	p.Lon = tok.CommaFloat()
+	p.Alt = tok.CommaFloat()
+	p.ClockOffset = tok.CommaUnsignedInt()
+	p.TimeOfWeek = tok.CommaUnsignedInt()
+	p.WeekNumber = tok.CommaUnsignedInt()
+	p.ChannelCount = tok.CommaUnsignedInt()
+	p.ResetConfig = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PSRF104) Encode(b *nmea.Builder) {
+	b.CommaFloat(p.Lat, -1)
+	b.CommaFloat(p.Lon, -1)
+	b.CommaFloat(p.Alt, -1)
+	b.CommaInt(p.ClockOffset)
+	b.CommaInt(p.TimeOfWeek)
+	b.CommaInt(p.WeekNumber)
+	b.CommaInt(p.ChannelCount)
+	b.CommaInt(p.ResetConfig)
+}
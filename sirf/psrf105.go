@@ -0,0 +1,28 @@
+package sirf
+
+import "github.com/twpayne/go-nmea"
+
+// A PSRF105 is a development data on/off command.
+type PSRF105 struct {
+	nmea.Address
+	Debug bool
+}
+
+func ParsePSRF105(addr string, tok *nmea.Tokenizer) (*PSRF105, error) {
+	var p PSRF105
+	p.Address = nmea.NewAddress(addr)
+	p.Debug = tok.CommaOneByteOf("01") == '1'
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PSRF105) Encode(b *nmea.Builder) {
+	b.CommaByte(boolByte(p.Debug))
+}
+
+func boolByte(value bool) byte {
+	if value {
+		return '1'
+	}
+	return '0'
+}
@@ -0,0 +1,22 @@
+package sirf
+
+import "github.com/twpayne/go-nmea"
+
+// A PSRF150 is an OK to send sentence, which the receiver outputs when it
+// is ready, or no longer ready, to receive commands.
+type PSRF150 struct {
+	nmea.Address
+	OKToSend bool
+}
+
+func ParsePSRF150(addr string, tok *nmea.Tokenizer) (*PSRF150, error) {
+	var p PSRF150
+	p.Address = nmea.NewAddress(addr)
+	p.OKToSend = tok.CommaOneByteOf("01") == '1'
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PSRF150) Encode(b *nmea.Builder) {
+	b.CommaByte(boolByte(p.OKToSend))
+}
@@ -0,0 +1,35 @@
+package sirf
+
+import "github.com/twpayne/go-nmea"
+
+// A PSRF151 is a GPS data and extended ephemeris mask sentence, which the
+// receiver outputs to request extended ephemeris for the satellites in
+// EphemerisRequestMask.
+type PSRF151 struct {
+	nmea.Address
+	GPSTimeValid         int
+	WeekNumber           int
+	TimeOfWeek           float64
+	EphemerisRequestMask int
+}
+
+func ParsePSRF151(addr string, tok *nmea.Tokenizer) (*PSRF151, error) {
+	var p PSRF151
+	p.Address = nmea.NewAddress(addr)
+	p.GPSTimeValid = tok.CommaUnsignedInt()
+	p.WeekNumber = tok.CommaUnsignedInt()
+	p.TimeOfWeek = tok.CommaUnsignedFloat()
+	tok.CommaLiteralString("0x")
+	p.EphemerisRequestMask = tok.Hex()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PSRF151) Encode(b *nmea.Builder) {
+	b.CommaInt(p.GPSTimeValid)
+	b.CommaInt(p.WeekNumber)
+	b.CommaFloat(p.TimeOfWeek, 1)
+	b.Comma()
+	b.String("0x")
+	b.Hex(p.EphemerisRequestMask, 8)
+}
@@ -0,0 +1,21 @@
+package sirf
+
+import "github.com/twpayne/go-nmea"
+
+// A PSRF154 is an extended ephemeris acknowledgement sentence.
+type PSRF154 struct {
+	nmea.Address
+	AckID int
+}
+
+func ParsePSRF154(addr string, tok *nmea.Tokenizer) (*PSRF154, error) {
+	var p PSRF154
+	p.Address = nmea.NewAddress(addr)
+	p.AckID = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PSRF154) Encode(b *nmea.Builder) {
+	b.CommaInt(p.AckID)
+}
@@ -0,0 +1,26 @@
+// Package sirf parses and encodes SiRF NMEA sentences.
+//
+// Input commands (PSRF100 to PSRF105) implement nmea.SentenceEncoder, so
+// nmea.Marshal encodes them with their checksum and line ending.
+//
+// See https://cdn.sparkfun.com/datasheets/Sensors/GPS/SiRF%20NMEA%20Reference%20Manual.pdf.
+package sirf
+
+import (
+	"github.com/twpayne/go-nmea"
+)
+
+var sentenceParserMap = nmea.SentenceParserMap{
+	"PSRF100": nmea.MakeSentenceParser(ParsePSRF100),
+	"PSRF101": nmea.MakeSentenceParser(ParsePSRF101),
+	"PSRF103": nmea.MakeSentenceParser(ParsePSRF103),
+	"PSRF104": nmea.MakeSentenceParser(ParsePSRF104),
+	"PSRF105": nmea.MakeSentenceParser(ParsePSRF105),
+	"PSRF150": nmea.MakeSentenceParser(ParsePSRF150),
+	"PSRF151": nmea.MakeSentenceParser(ParsePSRF151),
+	"PSRF154": nmea.MakeSentenceParser(ParsePSRF154),
+}
+
+func SentenceParserFunc(addr string) nmea.SentenceParser {
+	return sentenceParserMap[addr]
+}
@@ -0,0 +1,108 @@
+package sirf_test
+
+import (
+	"testing"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/nmeatest"
+	"github.com/twpayne/go-nmea/sirf"
+)
+
+func TestSentenceParserFunc(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(sirf.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$PSRF100,1,9600,8,1,0*0D",
+				Expected: &sirf.PSRF100{
+					Address:  nmea.NewAddress("PSRF100"),
+					Protocol: sirf.ProtocolNMEA,
+					BaudRate: 9600,
+					DataBits: 8,
+					StopBits: 1,
+					Parity:   sirf.ParityNone,
+				},
+			},
+			{
+				S: "$PSRF101,-2686700,-4304200,3851624,96000,497260,921,12,3*2F",
+				Expected: &sirf.PSRF101{
+					Address:      nmea.NewAddress("PSRF101"),
+					X:            -2686700,
+					Y:            -4304200,
+					Z:            3851624,
+					ClockOffset:  96000,
+					TimeOfWeek:   497260,
+					WeekNumber:   921,
+					ChannelCount: 12,
+					ResetConfig:  sirf.ResetConfigDataValid | sirf.ResetConfigClearEphemeris,
+				},
+			},
+			{
+				S: "$PSRF103,00,01,00,01*25",
+				Expected: &sirf.PSRF103{
+					Address:        nmea.NewAddress("PSRF103"),
+					Message:        sirf.MessageGGA,
+					Mode:           sirf.ModeQuery,
+					ChecksumEnable: true,
+				},
+			},
+			{
+				S: "$PSRF103,05,00,01,01*20",
+				Expected: &sirf.PSRF103{
+					Address:        nmea.NewAddress("PSRF103"),
+					Message:        sirf.MessageVTG,
+					Mode:           sirf.ModeSetRate,
+					Rate:           1,
+					ChecksumEnable: true,
+				},
+			},
+			{
+				S: "$PSRF104,37.3875111,-121.97232,0,96000,237759,1946,12,1*06",
+				Expected: &sirf.PSRF104{
+					Address:      nmea.NewAddress("PSRF104"),
+					Lat:          37.3875111,
+					Lon:          -121.97232,
+					ClockOffset:  96000,
+					TimeOfWeek:   237759,
+					WeekNumber:   1946,
+					ChannelCount: 12,
+					ResetConfig:  sirf.ResetConfigDataValid,
+				},
+			},
+			{
+				S: "$PSRF105,1*3E",
+				Expected: &sirf.PSRF105{
+					Address: nmea.NewAddress("PSRF105"),
+					Debug:   true,
+				},
+			},
+			{
+				S: "$PSRF150,1*3E",
+				Expected: &sirf.PSRF150{
+					Address:  nmea.NewAddress("PSRF150"),
+					OKToSend: true,
+				},
+			},
+			{
+				S: "$PSRF151,3,1485,147236.3,0x3FFFFFFF*3C",
+				Expected: &sirf.PSRF151{
+					Address:              nmea.NewAddress("PSRF151"),
+					GPSTimeValid:         3,
+					WeekNumber:           1485,
+					TimeOfWeek:           147236.3,
+					EphemerisRequestMask: 0x3fffffff,
+				},
+			},
+			{
+				S: "$PSRF154,110*3B",
+				Expected: &sirf.PSRF154{
+					Address: nmea.NewAddress("PSRF154"),
+					AckID:   110,
+				},
+			},
+		})
+}
@@ -0,0 +1,79 @@
+package soaring
+
+import "github.com/twpayne/go-nmea"
+
+// Values that LK8EX1 sentences use for unavailable data.
+const (
+	lk8ex1PressureNotAvailable    = 999999
+	lk8ex1AltitudeNotAvailable    = 99999
+	lk8ex1VarioNotAvailable       = 9999
+	lk8ex1TemperatureNotAvailable = 99
+	lk8ex1BatteryNotAvailable     = 999
+)
+
+// An LK8EX1 is an LK8000 external instrument sentence. Pressure is in Pa,
+// Altitude is in meters, Vario is in cm/s, and Temperature is in degrees
+// Celsius. The battery is reported either as a voltage in volts or as a
+// percentage. LK8EX1 sentences end with an empty field, which is optional
+// when parsing.
+type LK8EX1 struct {
+	nmea.Address
+	Pressure          nmea.Optional[int]
+	Altitude          nmea.Optional[int]
+	Vario             nmea.Optional[int]
+	Temperature       nmea.Optional[float64]
+	BatteryVoltage    nmea.Optional[float64]
+	BatteryPercentage nmea.Optional[int]
+}
+
+func ParseLK8EX1(addr string, tok *nmea.Tokenizer) (*LK8EX1, error) {
+	var l LK8EX1
+	l.Address = nmea.NewAddress(addr)
+	if pressure := tok.CommaUnsignedInt(); pressure != lk8ex1PressureNotAvailable {
+		l.Pressure = nmea.NewOptional(pressure)
+	}
+	if altitude := tok.CommaInt(); altitude != lk8ex1AltitudeNotAvailable {
+		l.Altitude = nmea.NewOptional(altitude)
+	}
+	if vario := tok.CommaInt(); vario != lk8ex1VarioNotAvailable {
+		l.Vario = nmea.NewOptional(vario)
+	}
+	if temperature := tok.CommaFloat(); temperature != lk8ex1TemperatureNotAvailable {
+		l.Temperature = nmea.NewOptional(temperature)
+	}
+	switch battery := tok.CommaUnsignedFloat(); {
+	case battery == lk8ex1BatteryNotAvailable:
+	case battery >= 1000:
+		l.BatteryPercentage = nmea.NewOptional(int(battery) - 1000)
+	default:
+		l.BatteryVoltage = nmea.NewOptional(battery)
+	}
+	if !tok.AtEndOfData() {
+		tok.CommaEmpty()
+	}
+	tok.EndOfData()
+	return &l, tok.Err()
+}
+
+func (l *LK8EX1) Encode(b *nmea.Builder) {
+	b.CommaInt(optionalValueOr(l.Pressure, lk8ex1PressureNotAvailable))
+	b.CommaInt(optionalValueOr(l.Altitude, lk8ex1AltitudeNotAvailable))
+	b.CommaInt(optionalValueOr(l.Vario, lk8ex1VarioNotAvailable))
+	b.CommaFloat(optionalValueOr(l.Temperature, lk8ex1TemperatureNotAvailable), -1)
+	switch {
+	case l.BatteryPercentage.Valid:
+		b.CommaInt(1000 + l.BatteryPercentage.Value)
+	case l.BatteryVoltage.Valid:
+		b.CommaFloat(l.BatteryVoltage.Value, -1)
+	default:
+		b.CommaInt(lk8ex1BatteryNotAvailable)
+	}
+	b.Comma()
+}
+
+func optionalValueOr[T any](o nmea.Optional[T], value T) T {
+	if o.Valid {
+		return o.Value
+	}
+	return value
+}
@@ -0,0 +1,49 @@
+package soaring
+
+import "github.com/twpayne/go-nmea"
+
+// A PBB50 is a Borgelt B50 vario sentence. TrueAirspeed, Vario, and
+// MacCready are in knots, IASSquared is in knots squared, Bugs is the
+// degradation in percent, Ballast is a factor between 1.00 and 1.60, and OAT
+// is the outside air temperature in degrees Celsius.
+type PBB50 struct {
+	nmea.Address
+	TrueAirspeed float64
+	Vario        float64
+	MacCready    float64
+	IASSquared   float64
+	Bugs         int
+	Ballast      float64
+	Cruise       bool
+	OAT          float64
+}
+
+func ParsePBB50(addr string, tok *nmea.Tokenizer) (*PBB50, error) {
+	var p PBB50
+	p.Address = nmea.NewAddress(addr)
+	p.TrueAirspeed = tok.CommaUnsignedFloat()
+	p.Vario = tok.CommaFloat()
+	p.MacCready = tok.CommaUnsignedFloat()
+	p.IASSquared = tok.CommaUnsignedFloat()
+	p.Bugs = tok.CommaUnsignedInt()
+	p.Ballast = tok.CommaUnsignedFloat()
+	p.Cruise = tok.CommaOneByteOf("01") == '1'
+	p.OAT = tok.CommaFloat()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PBB50) Encode(b *nmea.Builder) {
+	b.CommaFloat(p.TrueAirspeed, 0)
+	b.CommaFloat(p.Vario, 1)
+	b.CommaFloat(p.MacCready, 1)
+	b.CommaFloat(p.IASSquared, 0)
+	b.CommaInt(p.Bugs)
+	b.CommaFloat(p.Ballast, 2)
+	if p.Cruise {
+		b.CommaByte('1')
+	} else {
+		b.CommaByte('0')
+	}
+	b.CommaFloat(p.OAT, 0)
+}
@@ -0,0 +1,35 @@
+package soaring
+
+import "github.com/twpayne/go-nmea"
+
+// A PCAID is a Cambridge CAI302 logger status sentence. BaroAltitude is in
+// meters.
+type PCAID struct {
+	nmea.Address
+	Logged           bool
+	BaroAltitude     int
+	EngineNoiseLevel int
+	LogFlags         string
+}
+
+func ParsePCAID(addr string, tok *nmea.Tokenizer) (*PCAID, error) {
+	var p PCAID
+	p.Address = nmea.NewAddress(addr)
+	p.Logged = tok.CommaOneByteOf("LN") == 'L'
+	p.BaroAltitude = tok.CommaInt()
+	p.EngineNoiseLevel = tok.CommaUnsignedInt()
+	p.LogFlags = tok.CommaString()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PCAID) Encode(b *nmea.Builder) {
+	if p.Logged {
+		b.CommaByte('L')
+	} else {
+		b.CommaByte('N')
+	}
+	b.CommaInt(p.BaroAltitude)
+	b.CommaInt(p.EngineNoiseLevel)
+	b.CommaString(p.LogFlags)
+}
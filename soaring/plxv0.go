@@ -0,0 +1,39 @@
+package soaring
+
+import "github.com/twpayne/go-nmea"
+
+// PLXV0 directions.
+const (
+	PLXV0DirectionRead  byte = 'R'
+	PLXV0DirectionWrite byte = 'W'
+)
+
+// A PLXV0 reads or writes an LXNAV vario setting, for example BAL (ballast)
+// or MC (MacCready). The vario answers reads and writes with a PLXV0 that
+// contains the setting's current values.
+type PLXV0 struct {
+	nmea.Address
+	Name      string
+	Direction byte
+	Values    []string
+}
+
+func ParsePLXV0(addr string, tok *nmea.Tokenizer) (*PLXV0, error) {
+	var p PLXV0
+	p.Address = nmea.NewAddress(addr)
+	p.Name = tok.CommaString()
+	p.Direction = tok.CommaOneByteOf("RW")
+	for !tok.AtEndOfData() {
+		p.Values = append(p.Values, tok.CommaString())
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PLXV0) Encode(b *nmea.Builder) {
+	b.CommaString(p.Name)
+	b.CommaByte(p.Direction)
+	for _, value := range p.Values {
+		b.CommaString(value)
+	}
+}
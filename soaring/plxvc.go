@@ -0,0 +1,92 @@
+package soaring
+
+import (
+	"strconv"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// PLXVC keys.
+const (
+	PLXVCKeyDeclaration = "DECL"
+	PLXVCKeyInfo        = "INFO"
+)
+
+// PLXVC types.
+const (
+	PLXVCTypeAnswer  byte = 'A'
+	PLXVCTypeRequest byte = 'R'
+	PLXVCTypeWrite   byte = 'W'
+)
+
+// A PLXVC is an LX flight computer command or answer. Declarations are sent
+// and received as DECL commands, one line of the declaration, in IGC
+// format, per command.
+type PLXVC struct {
+	nmea.Address
+	Key    string
+	Type   byte
+	Values []string
+}
+
+// A DeclarationLine is a line of a flight declaration. Number starts at 1.
+type DeclarationLine struct {
+	Number int
+	Count  int
+	Text   string
+}
+
+// NewDeclaration returns the DECL commands that write a declaration with
+// lines.
+func NewDeclaration(lines []string) []*PLXVC {
+	commands := make([]*PLXVC, 0, len(lines))
+	for i, line := range lines {
+		commands = append(commands, &PLXVC{
+			Address: nmea.NewAddress("PLXVC"),
+			Key:     PLXVCKeyDeclaration,
+			Type:    PLXVCTypeWrite,
+			Values:  []string{strconv.Itoa(i + 1), strconv.Itoa(len(lines)), line},
+		})
+	}
+	return commands
+}
+
+func ParsePLXVC(addr string, tok *nmea.Tokenizer) (*PLXVC, error) {
+	var p PLXVC
+	p.Address = nmea.NewAddress(addr)
+	p.Key = tok.CommaString()
+	p.Type = tok.CommaOneByteOf("ARW")
+	for !tok.AtEndOfData() {
+		p.Values = append(p.Values, tok.CommaString())
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PLXVC) Encode(b *nmea.Builder) {
+	b.CommaString(p.Key)
+	b.CommaByte(p.Type)
+	for _, value := range p.Values {
+		b.CommaString(value)
+	}
+}
+
+// DeclarationLine returns the declaration line written or answered by p.
+func (p *PLXVC) DeclarationLine() (DeclarationLine, bool) {
+	if p.Key != PLXVCKeyDeclaration || p.Type == PLXVCTypeRequest || len(p.Values) != 3 {
+		return DeclarationLine{}, false
+	}
+	number, err := strconv.Atoi(p.Values[0])
+	if err != nil {
+		return DeclarationLine{}, false
+	}
+	count, err := strconv.Atoi(p.Values[1])
+	if err != nil {
+		return DeclarationLine{}, false
+	}
+	return DeclarationLine{
+		Number: number,
+		Count:  count,
+		Text:   p.Values[2],
+	}, true
+}
@@ -0,0 +1,41 @@
+package soaring
+
+import "github.com/twpayne/go-nmea"
+
+// A PLXVF is an LXNAV vario fast data sentence. Time is in seconds,
+// accelerations are in g, Vario is in m/s, IAS is in km/h, and
+// PressureAltitude is in meters.
+type PLXVF struct {
+	nmea.Address
+	Time             float64
+	AccX             nmea.Optional[float64]
+	AccY             nmea.Optional[float64]
+	AccZ             nmea.Optional[float64]
+	Vario            float64
+	IAS              float64
+	PressureAltitude float64
+}
+
+func ParsePLXVF(addr string, tok *nmea.Tokenizer) (*PLXVF, error) {
+	var p PLXVF
+	p.Address = nmea.NewAddress(addr)
+	p.Time = tok.CommaUnsignedFloat()
+	p.AccX = tok.CommaOptionalFloat()
+	p.AccY = tok.CommaOptionalFloat()
+	p.AccZ = tok.CommaOptionalFloat()
+	p.Vario = tok.CommaFloat()
+	p.IAS = tok.CommaFloat()
+	p.PressureAltitude = tok.CommaFloat()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PLXVF) Encode(b *nmea.Builder) {
+	b.CommaFloat(p.Time, 2)
+	b.CommaOptionalFloat(p.AccX, 2)
+	b.CommaOptionalFloat(p.AccY, 2)
+	b.CommaOptionalFloat(p.AccZ, 2)
+	b.CommaFloat(p.Vario, 2)
+	b.CommaFloat(p.IAS, 1)
+	b.CommaFloat(p.PressureAltitude, 1)
+}
@@ -0,0 +1,34 @@
+package soaring
+
+import "github.com/twpayne/go-nmea"
+
+// PLXVS modes.
+const (
+	PLXVSModeVario      = 0
+	PLXVSModeSpeedToFly = 1
+)
+
+// A PLXVS is an LXNAV vario slow data sentence. OAT is the outside air
+// temperature in degrees Celsius and Voltage is in volts.
+type PLXVS struct {
+	nmea.Address
+	OAT     float64
+	Mode    int
+	Voltage float64
+}
+
+func ParsePLXVS(addr string, tok *nmea.Tokenizer) (*PLXVS, error) {
+	var p PLXVS
+	p.Address = nmea.NewAddress(addr)
+	p.OAT = tok.CommaFloat()
+	p.Mode = tok.CommaUnsignedInt()
+	p.Voltage = tok.CommaUnsignedFloat()
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PLXVS) Encode(b *nmea.Builder) {
+	b.CommaFloat(p.OAT, 1)
+	b.CommaInt(p.Mode)
+	b.CommaFloat(p.Voltage, 1)
+}
@@ -0,0 +1,70 @@
+package soaring
+
+import "github.com/twpayne/go-nmea"
+
+// A POV is an OpenVario sensor data sentence, which contains any number of
+// type-value pairs. StaticPressure and TotalPressure are in hPa,
+// DynamicPressure is in Pa, TrueAirspeed is in km/h, Temperature is in
+// degrees Celsius, Vario is in m/s, Humidity is in percent, and Voltage is in
+// volts.
+type POV struct {
+	nmea.Address
+	StaticPressure  nmea.Optional[float64]
+	DynamicPressure nmea.Optional[float64]
+	TotalPressure   nmea.Optional[float64]
+	TrueAirspeed    nmea.Optional[float64]
+	Temperature     nmea.Optional[float64]
+	Vario           nmea.Optional[float64]
+	Humidity        nmea.Optional[float64]
+	Voltage         nmea.Optional[float64]
+}
+
+func ParsePOV(addr string, tok *nmea.Tokenizer) (*POV, error) {
+	var p POV
+	p.Address = nmea.NewAddress(addr)
+	for !tok.AtEndOfData() {
+		valueType := tok.CommaOneByteOf("EHPQRSTV")
+		value := nmea.NewOptional(tok.CommaFloat())
+		switch valueType {
+		case 'E':
+			p.Vario = value
+		case 'H':
+			p.Humidity = value
+		case 'P':
+			p.StaticPressure = value
+		case 'Q':
+			p.DynamicPressure = value
+		case 'R':
+			p.TotalPressure = value
+		case 'S':
+			p.TrueAirspeed = value
+		case 'T':
+			p.Temperature = value
+		case 'V':
+			p.Voltage = value
+		}
+	}
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *POV) Encode(b *nmea.Builder) {
+	for _, pair := range []struct {
+		valueType byte
+		value     nmea.Optional[float64]
+	}{
+		{'P', p.StaticPressure},
+		{'Q', p.DynamicPressure},
+		{'R', p.TotalPressure},
+		{'S', p.TrueAirspeed},
+		{'T', p.Temperature},
+		{'E', p.Vario},
+		{'H', p.Humidity},
+		{'V', p.Voltage},
+	} {
+		if pair.value.Valid {
+			b.CommaByte(pair.valueType)
+			b.CommaFloat(pair.value.Value, -1)
+		}
+	}
+}
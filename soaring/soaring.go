@@ -0,0 +1,26 @@
+// Package soaring parses and encodes NMEA sentences used by variometers and
+// glide computers: LXNAV PLXV*, OpenVario POV, LK8000 LK8EX1, Cambridge !w
+// and PCAID, and Borgelt PBB50.
+//
+// See https://github.com/XCSoar/XCSoar/tree/master/src/Device/Driver.
+package soaring
+
+import (
+	"github.com/twpayne/go-nmea"
+)
+
+var sentenceParserMap = nmea.SentenceParserMap{
+	"LK8EX1": nmea.MakeSentenceParser(ParseLK8EX1),
+	"PBB50":  nmea.MakeSentenceParser(ParsePBB50),
+	"PCAID":  nmea.MakeSentenceParser(ParsePCAID),
+	"PLXV0":  nmea.MakeSentenceParser(ParsePLXV0),
+	"PLXVC":  nmea.MakeSentenceParser(ParsePLXVC),
+	"PLXVF":  nmea.MakeSentenceParser(ParsePLXVF),
+	"PLXVS":  nmea.MakeSentenceParser(ParsePLXVS),
+	"POV":    nmea.MakeSentenceParser(ParsePOV),
+	"w":      nmea.MakeSentenceParser(ParseW),
+}
+
+func SentenceParserFunc(addr string) nmea.SentenceParser {
+	return sentenceParserMap[addr]
+}
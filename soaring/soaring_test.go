@@ -0,0 +1,167 @@
+package soaring_test
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/nmeatest"
+	"github.com/twpayne/go-nmea/soaring"
+)
+
+func TestSentenceParserFunc(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(soaring.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "!w,270,52,12,505,1512,1013,2750,212,205,199,15,50,2*5A",
+				Expected: &soaring.W{
+					Address:       nmea.NewAddress("w"),
+					WindDirection: 270,
+					WindSpeed:     5.2,
+					WindAge:       12,
+					ComponentWind: 0.5,
+					Altitude:      512,
+					QNH:           1013,
+					TrueAirspeed:  27.5,
+					Vario:         1.2,
+					Averager:      0.5,
+					RelativeVario: -0.1,
+					MacCready:     1.5,
+					Ballast:       50,
+					Bugs:          2,
+				},
+			},
+			{
+				S: "$LK8EX1,101325,99999,-5,22,999,*0F",
+				Expected: &soaring.LK8EX1{
+					Address:     nmea.NewAddress("LK8EX1"),
+					Pressure:    nmea.NewOptional(101325),
+					Vario:       nmea.NewOptional(-5),
+					Temperature: nmea.NewOptional(22.0),
+				},
+			},
+			{
+				S: "$LK8EX1,98684,99999,-12,27.5,1085*01",
+				Expected: &soaring.LK8EX1{
+					Address:           nmea.NewAddress("LK8EX1"),
+					Pressure:          nmea.NewOptional(98684),
+					Vario:             nmea.NewOptional(-12),
+					Temperature:       nmea.NewOptional(27.5),
+					BatteryPercentage: nmea.NewOptional(85),
+				},
+			},
+			{
+				S: "$LK8EX1,999999,1234,9999,99,12.4*22",
+				Expected: &soaring.LK8EX1{
+					Address:        nmea.NewAddress("LK8EX1"),
+					Altitude:       nmea.NewOptional(1234),
+					BatteryVoltage: nmea.NewOptional(12.4),
+				},
+			},
+			{
+				S: "$PBB50,042,-01.1,1.0,01764,10,1.30,1,-28*40",
+				Expected: &soaring.PBB50{
+					Address:      nmea.NewAddress("PBB50"),
+					TrueAirspeed: 42,
+					Vario:        -1.1,
+					MacCready:    1,
+					IASSquared:   1764,
+					Bugs:         10,
+					Ballast:      1.3,
+					Cruise:       true,
+					OAT:          -28,
+				},
+			},
+			{
+				S: "$PCAID,L,01234,000,00*17",
+				Expected: &soaring.PCAID{
+					Address:      nmea.NewAddress("PCAID"),
+					Logged:       true,
+					BaroAltitude: 1234,
+					LogFlags:     "00",
+				},
+			},
+			{
+				S: "$PLXV0,BAL,W,1.2*3B",
+				Expected: &soaring.PLXV0{
+					Address:   nmea.NewAddress("PLXV0"),
+					Name:      "BAL",
+					Direction: soaring.PLXV0DirectionWrite,
+					Values:    []string{"1.2"},
+				},
+			},
+			{
+				S: "$PLXVC,DECL,R,1,2*0E",
+				Expected: &soaring.PLXVC{
+					Address: nmea.NewAddress("PLXVC"),
+					Key:     soaring.PLXVCKeyDeclaration,
+					Type:    soaring.PLXVCTypeRequest,
+					Values:  []string{"1", "2"},
+				},
+			},
+			{
+				S: "$PLXVC,DECL,W,1,2,HFPLTPILOT:John Doe*58",
+				Expected: &soaring.PLXVC{
+					Address: nmea.NewAddress("PLXVC"),
+					Key:     soaring.PLXVCKeyDeclaration,
+					Type:    soaring.PLXVCTypeWrite,
+					Values:  []string{"1", "2", "HFPLTPILOT:John Doe"},
+				},
+			},
+			{
+				S: "$PLXVF,1.00,0.87,-0.12,-0.25,0.53,90.2,1234.5*60",
+				Expected: &soaring.PLXVF{
+					Address:          nmea.NewAddress("PLXVF"),
+					Time:             1,
+					AccX:             nmea.NewOptional(0.87),
+					AccY:             nmea.NewOptional(-0.12),
+					AccZ:             nmea.NewOptional(-0.25),
+					Vario:            0.53,
+					IAS:              90.2,
+					PressureAltitude: 1234.5,
+				},
+			},
+			{
+				S: "$PLXVS,23.1,0,12.3*5D",
+				Expected: &soaring.PLXVS{
+					Address: nmea.NewAddress("PLXVS"),
+					OAT:     23.1,
+					Mode:    soaring.PLXVSModeVario,
+					Voltage: 12.3,
+				},
+			},
+			{
+				S: "$POV,P,1018.35,Q,23.3,E,2.15*29",
+				Expected: &soaring.POV{
+					Address:         nmea.NewAddress("POV"),
+					StaticPressure:  nmea.NewOptional(1018.35),
+					DynamicPressure: nmea.NewOptional(23.3),
+					Vario:           nmea.NewOptional(2.15),
+				},
+			},
+		})
+}
+
+func TestDeclaration(t *testing.T) {
+	commands := soaring.NewDeclaration([]string{
+		"HFPLTPILOT:John Doe",
+		"C4712345N00812345ETP1",
+	})
+	assert.Equal(t, 2, len(commands))
+	data, err := nmea.Marshal(commands[1])
+	assert.NoError(t, err)
+	assert.Equal(t, "$PLXVC,DECL,W,2,2,C4712345N00812345ETP1*62\r\n", string(data))
+	line, ok := commands[1].DeclarationLine()
+	assert.True(t, ok)
+	assert.Equal(t, soaring.DeclarationLine{
+		Number: 2,
+		Count:  2,
+		Text:   "C4712345N00812345ETP1",
+	}, line)
+}
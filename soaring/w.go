@@ -0,0 +1,68 @@
+package soaring
+
+import (
+	"math"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// A W is a Cambridge CAI302 !w sentence. The sentence uses the encapsulation
+// start delimiter !. Wind and airspeeds are in m/s, Altitude is in meters,
+// variometer and MacCready readings are in knots, and Ballast is a
+// percentage of capacity.
+type W struct {
+	nmea.Address
+	WindDirection int
+	WindSpeed     float64
+	WindAge       int
+	ComponentWind float64
+	Altitude      int
+	QNH           int
+	TrueAirspeed  float64
+	Vario         float64
+	Averager      float64
+	RelativeVario float64
+	MacCready     float64
+	Ballast       int
+	Bugs          int
+}
+
+func ParseW(addr string, tok *nmea.Tokenizer) (*W, error) {
+	var w W
+	w.Address = nmea.NewAddress(addr)
+	w.WindDirection = tok.CommaUnsignedInt()
+	w.WindSpeed = float64(tok.CommaUnsignedInt()) / 10
+	w.WindAge = tok.CommaUnsignedInt()
+	w.ComponentWind = float64(tok.CommaUnsignedInt()-500) / 10
+	w.Altitude = tok.CommaUnsignedInt() - 1000
+	w.QNH = tok.CommaUnsignedInt()
+	w.TrueAirspeed = float64(tok.CommaUnsignedInt()) / 100
+	w.Vario = float64(tok.CommaUnsignedInt()-200) / 10
+	w.Averager = float64(tok.CommaUnsignedInt()-200) / 10
+	w.RelativeVario = float64(tok.CommaUnsignedInt()-200) / 10
+	w.MacCready = float64(tok.CommaUnsignedInt()) / 10
+	w.Ballast = tok.CommaUnsignedInt()
+	w.Bugs = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &w, tok.Err()
+}
+
+func (w *W) Encode(b *nmea.Builder) {
+	b.CommaInt(w.WindDirection)
+	b.CommaInt(int(math.Round(10 * w.WindSpeed)))
+	b.CommaInt(w.WindAge)
+	b.CommaInt(500 + int(math.Round(10*w.ComponentWind)))
+	b.CommaInt(1000 + w.Altitude)
+	b.CommaInt(w.QNH)
+	b.CommaInt(int(math.Round(100 * w.TrueAirspeed)))
+	b.CommaInt(200 + int(math.Round(10*w.Vario)))
+	b.CommaInt(200 + int(math.Round(10*w.Averager)))
+	b.CommaInt(200 + int(math.Round(10*w.RelativeVario)))
+	b.CommaInt(int(math.Round(10 * w.MacCready)))
+	b.CommaInt(w.Ballast)
+	b.CommaInt(w.Bugs)
+}
+
+func (w *W) StartDelimiter() byte {
+	return '!'
+}
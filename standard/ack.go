@@ -0,0 +1,20 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type ACK struct {
+	nmea.Address
+	AlarmNumber int
+}
+
+func ParseACK(addr string, tok *nmea.Tokenizer) (*ACK, error) {
+	var ack ACK
+	ack.Address = nmea.NewAddress(addr)
+	ack.AlarmNumber = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &ack, tok.Err()
+}
+
+func (ack *ACK) Encode(b *nmea.Builder) {
+	b.CommaZeroPaddedInt(ack.AlarmNumber, 3)
+}
@@ -0,0 +1,35 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type ACN struct {
+	nmea.Address
+	TimeOfDay        nmea.Optional[nmea.TimeOfDay]
+	ManufacturerCode string
+	Identifier       int
+	Instance         nmea.Optional[int]
+	Command          byte
+	SentenceStatus   byte
+}
+
+func ParseACN(addr string, tok *nmea.Tokenizer) (*ACN, error) {
+	var acn ACN
+	acn.Address = nmea.NewAddress(addr)
+	acn.TimeOfDay = tok.CommaOptionalTimeOfDay()
+	acn.ManufacturerCode = tok.CommaString()
+	acn.Identifier = tok.CommaUnsignedInt()
+	acn.Instance = tok.CommaOptionalUnsignedInt()
+	acn.Command = tok.CommaOneByteOf("AOQS")
+	acn.SentenceStatus = tok.CommaOneByteOf("C")
+	tok.EndOfData()
+	return &acn, tok.Err()
+}
+
+func (acn *ACN) Encode(b *nmea.Builder) {
+	b.CommaOptionalTimeOfDay(acn.TimeOfDay, 2)
+	b.CommaString(acn.ManufacturerCode)
+	b.CommaInt(acn.Identifier)
+	b.CommaOptionalInt(acn.Instance)
+	b.CommaByte(acn.Command)
+	b.CommaByte(acn.SentenceStatus)
+}
@@ -0,0 +1,50 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type AlertEntry struct {
+	ManufacturerCode string
+	Identifier       int
+	Instance         nmea.Optional[int]
+	RevisionCounter  int
+}
+
+type ALC struct {
+	nmea.Address
+	NumSentences   int
+	SentenceNumber int
+	SequentialID   int
+	AlertEntries   []AlertEntry
+}
+
+func ParseALC(addr string, tok *nmea.Tokenizer) (*ALC, error) {
+	var alc ALC
+	alc.Address = nmea.NewAddress(addr)
+	alc.NumSentences = tok.CommaUnsignedInt()
+	alc.SentenceNumber = tok.CommaUnsignedInt()
+	alc.SequentialID = tok.CommaUnsignedInt()
+	numAlertEntries := tok.CommaUnsignedInt()
+	for i := 0; i < numAlertEntries && tok.Err() == nil; i++ {
+		var alertEntry AlertEntry
+		alertEntry.ManufacturerCode = tok.CommaString()
+		alertEntry.Identifier = tok.CommaUnsignedInt()
+		alertEntry.Instance = tok.CommaOptionalUnsignedInt()
+		alertEntry.RevisionCounter = tok.CommaUnsignedInt()
+		alc.AlertEntries = append(alc.AlertEntries, alertEntry)
+	}
+	tok.EndOfData()
+	return &alc, tok.Err()
+}
+
+func (alc *ALC) Encode(b *nmea.Builder) {
+	b.CommaZeroPaddedInt(alc.NumSentences, 2)
+	b.CommaZeroPaddedInt(alc.SentenceNumber, 2)
+	b.CommaZeroPaddedInt(alc.SequentialID, 2)
+	b.CommaInt(len(alc.AlertEntries))
+	for _, alertEntry := range alc.AlertEntries {
+		b.CommaString(alertEntry.ManufacturerCode)
+		b.CommaInt(alertEntry.Identifier)
+		b.CommaOptionalInt(alertEntry.Instance)
+		b.CommaInt(alertEntry.RevisionCounter)
+	}
+}
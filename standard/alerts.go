@@ -0,0 +1,248 @@
+package standard
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// Alert states, as used in ALF sentences.
+const (
+	AlertStateAcknowledged              byte = 'A'
+	AlertStateNormal                    byte = 'N'
+	AlertStateResponsibilityTransferred byte = 'O'
+	AlertStateSilenced                  byte = 'S'
+	AlertStateRectifiedUnacknowledged   byte = 'U'
+	AlertStateActiveUnacknowledged      byte = 'V'
+)
+
+// Alert commands, as used in ACN and ARC sentences.
+const (
+	AlertCommandAcknowledge            byte = 'A'
+	AlertCommandResponsibilityTransfer byte = 'O'
+	AlertCommandRequestRepeat          byte = 'Q'
+	AlertCommandSilence                byte = 'S'
+)
+
+// An AlertKey identifies an alert. Source is the talker of the sentences
+// that reported the alert. Alerts reported by legacy ALR sentences have an
+// empty ManufacturerCode and their Identifier is the local alarm number.
+type AlertKey struct {
+	Source           string
+	ManufacturerCode string
+	Identifier       int
+	Instance         int
+}
+
+// An Alert is the current state of an alert.
+type Alert struct {
+	AlertKey
+	Legacy            bool
+	TimeOfDay         nmea.Optional[nmea.TimeOfDay]
+	Category          byte
+	Priority          byte
+	State             byte
+	RevisionCounter   int
+	EscalationCounter int
+	Text              string
+}
+
+// An AlertModel tracks the active, acknowledged, and silenced alerts of each
+// source from ALF, ALR, and HBT sentences.
+type AlertModel struct {
+	alerts     map[AlertKey]*Alert
+	heartbeats map[string]*HBT
+	// continuations maps each source's sequential message identifiers to
+	// the alert whose ALF sentences are still being received.
+	continuations map[alertSequence]AlertKey
+}
+
+type alertSequence struct {
+	source       string
+	sequentialID int
+}
+
+// NewAlertModel returns a new, empty AlertModel.
+func NewAlertModel() *AlertModel {
+	return &AlertModel{
+		alerts:        make(map[AlertKey]*Alert),
+		heartbeats:    make(map[string]*HBT),
+		continuations: make(map[alertSequence]AlertKey),
+	}
+}
+
+// Acknowledge returns the ACK or ACN sentence, with the given talker, that
+// acknowledges the alert with key.
+func (m *AlertModel) Acknowledge(key AlertKey, talker string) nmea.SentenceEncoder {
+	if alert, ok := m.alerts[key]; ok && alert.Legacy {
+		return &ACK{
+			Address:     nmea.NewAddress(talker + "ACK"),
+			AlarmNumber: key.Identifier,
+		}
+	}
+	return m.Command(key, talker, AlertCommandAcknowledge)
+}
+
+// Active returns all active, unacknowledged alerts.
+func (m *AlertModel) Active() []Alert {
+	return m.alertsWithState(AlertStateActiveUnacknowledged)
+}
+
+// Acknowledged returns all acknowledged alerts.
+func (m *AlertModel) Acknowledged() []Alert {
+	return m.alertsWithState(AlertStateAcknowledged)
+}
+
+// Alert returns the alert with key.
+func (m *AlertModel) Alert(key AlertKey) (Alert, bool) {
+	alert, ok := m.alerts[key]
+	if !ok {
+		return Alert{}, false
+	}
+	return *alert, true
+}
+
+// Alerts returns all alerts, sorted by key.
+func (m *AlertModel) Alerts() []Alert {
+	return m.alertsWithState(0)
+}
+
+// Command returns the ACN sentence, with the given talker, that sends
+// command to the alert with key.
+func (m *AlertModel) Command(key AlertKey, talker string, command byte) *ACN {
+	return &ACN{
+		Address:          nmea.NewAddress(talker + "ACN"),
+		ManufacturerCode: key.ManufacturerCode,
+		Identifier:       key.Identifier,
+		Instance:         nmea.NewOptional(key.Instance),
+		Command:          command,
+		SentenceStatus:   'C',
+	}
+}
+
+// Heartbeat returns the last HBT sentence received from source.
+func (m *AlertModel) Heartbeat(source string) (*HBT, bool) {
+	hbt, ok := m.heartbeats[source]
+	return hbt, ok
+}
+
+// Silenced returns all silenced alerts.
+func (m *AlertModel) Silenced() []Alert {
+	return m.alertsWithState(AlertStateSilenced)
+}
+
+// Update updates m with sentence and returns whether any alert changed.
+// Sentences other than ALF, ALR, and HBT are ignored.
+func (m *AlertModel) Update(sentence nmea.Sentence) bool {
+	switch sentence := sentence.(type) {
+	case *ALF:
+		return m.updateALF(sentence)
+	case *ALR:
+		return m.updateALR(sentence)
+	case *HBT:
+		m.heartbeats[sentence.Talker()] = sentence
+	}
+	return false
+}
+
+func (m *AlertModel) alertsWithState(state byte) []Alert {
+	var alerts []Alert
+	for _, alert := range m.alerts {
+		if state == 0 || alert.State == state {
+			alerts = append(alerts, *alert)
+		}
+	}
+	slices.SortFunc(alerts, func(a, b Alert) int {
+		return cmp.Or(
+			cmp.Compare(a.Source, b.Source),
+			cmp.Compare(a.ManufacturerCode, b.ManufacturerCode),
+			cmp.Compare(a.Identifier, b.Identifier),
+			cmp.Compare(a.Instance, b.Instance),
+		)
+	})
+	return alerts
+}
+
+func (m *AlertModel) updateALF(alf *ALF) bool {
+	sequence := alertSequence{
+		source:       alf.Talker(),
+		sequentialID: alf.SequentialID.Value,
+	}
+
+	// Subsequent sentences only continue the alert's text.
+	if alf.SentenceNumber > 1 {
+		key, ok := m.continuations[sequence]
+		if !ok {
+			return false
+		}
+		if alf.SentenceNumber == alf.NumSentences {
+			delete(m.continuations, sequence)
+		}
+		alert, ok := m.alerts[key]
+		if !ok || alf.Text == "" {
+			return false
+		}
+		alert.Text += alf.Text
+		return true
+	}
+
+	key := AlertKey{
+		Source:           alf.Talker(),
+		ManufacturerCode: alf.ManufacturerCode,
+		Identifier:       alf.Identifier.Value,
+		Instance:         alf.Instance.Value,
+	}
+	if alf.State.Value == AlertStateNormal {
+		delete(m.continuations, sequence)
+		if _, ok := m.alerts[key]; !ok {
+			return false
+		}
+		delete(m.alerts, key)
+		return true
+	}
+	if alf.NumSentences > 1 {
+		m.continuations[sequence] = key
+	}
+	m.alerts[key] = &Alert{
+		AlertKey:          key,
+		TimeOfDay:         alf.TimeOfDay,
+		Category:          alf.Category.Value,
+		Priority:          alf.Priority.Value,
+		State:             alf.State.Value,
+		RevisionCounter:   alf.RevisionCounter.Value,
+		EscalationCounter: alf.EscalationCounter.Value,
+		Text:              alf.Text,
+	}
+	return true
+}
+
+func (m *AlertModel) updateALR(alr *ALR) bool {
+	key := AlertKey{
+		Source:     alr.Talker(),
+		Identifier: alr.AlarmNumber,
+	}
+	if alr.Condition == 'V' && alr.AcknowledgeState == 'A' {
+		if _, ok := m.alerts[key]; !ok {
+			return false
+		}
+		delete(m.alerts, key)
+		return true
+	}
+	state := AlertStateActiveUnacknowledged
+	switch {
+	case alr.Condition == 'V':
+		state = AlertStateRectifiedUnacknowledged
+	case alr.AcknowledgeState == 'A':
+		state = AlertStateAcknowledged
+	}
+	m.alerts[key] = &Alert{
+		AlertKey:  key,
+		Legacy:    true,
+		TimeOfDay: alr.TimeOfDay,
+		Priority:  'A',
+		State:     state,
+		Text:      alr.Description,
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+package standard_test
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+func TestAlertModel(t *testing.T) {
+	parser := nmea.NewParser(
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+		nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+	)
+	update := func(m *standard.AlertModel, s string) bool {
+		t.Helper()
+		sentence, err := parser.ParseString(s)
+		assert.NoError(t, err)
+		return m.Update(sentence)
+	}
+
+	m := standard.NewAlertModel()
+	assert.True(t, update(m, "$VRALF,2,1,1,081950.10,B,A,V,XYZ,0512,1,2,0,HEADING LOST*2C"))
+	assert.True(t, update(m, "$VRALF,2,2,1,,,,,XYZ,0512,1,2,0,: GYRO FAILURE*45"))
+	assert.True(t, update(m, "$IIALR,161229.00,001,A,V,HIGH WATER*0F"))
+	assert.False(t, update(m, "$VRHBT,5,A,3*31"))
+
+	headingLostKey := standard.AlertKey{
+		Source:           "VR",
+		ManufacturerCode: "XYZ",
+		Identifier:       512,
+		Instance:         1,
+	}
+	highWaterKey := standard.AlertKey{
+		Source:     "II",
+		Identifier: 1,
+	}
+	assert.Equal(t, []standard.Alert{
+		{
+			AlertKey: highWaterKey,
+			Legacy:   true,
+			TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+				Hour:   16,
+				Minute: 12,
+				Second: 29,
+			}),
+			Priority: 'A',
+			State:    standard.AlertStateActiveUnacknowledged,
+			Text:     "HIGH WATER",
+		},
+		{
+			AlertKey: headingLostKey,
+			TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+				Hour:       8,
+				Minute:     19,
+				Second:     50,
+				Nanosecond: 100000000,
+			}),
+			Category:        'B',
+			Priority:        'A',
+			State:           standard.AlertStateActiveUnacknowledged,
+			RevisionCounter: 2,
+			Text:            "HEADING LOST: GYRO FAILURE",
+		},
+	}, m.Active())
+	assert.Equal(t, 0, len(m.Acknowledged()))
+
+	hbt, ok := m.Heartbeat("VR")
+	assert.True(t, ok)
+	assert.Equal(t, 3, hbt.SequentialID)
+
+	data, err := nmea.Marshal(m.Acknowledge(headingLostKey, "II"))
+	assert.NoError(t, err)
+	assert.Equal(t, "$IIACN,,XYZ,512,1,A,C*12\r\n", string(data))
+	data, err = nmea.Marshal(m.Acknowledge(highWaterKey, "II"))
+	assert.NoError(t, err)
+	assert.Equal(t, "$IIACK,001*54\r\n", string(data))
+
+	assert.True(t, update(m, "$VRALF,1,1,2,082001.00,B,A,S,XYZ,0512,1,3,0,HEADING LOST*27"))
+	assert.True(t, update(m, "$IIALR,161230.00,001,A,A,HIGH WATER*10"))
+	assert.Equal(t, 0, len(m.Active()))
+	assert.Equal(t, 1, len(m.Silenced()))
+	assert.Equal(t, 1, len(m.Acknowledged()))
+
+	assert.True(t, update(m, "$VRALF,1,1,3,082010.00,B,A,N,XYZ,0512,1,4,0,HEADING LOST*3C"))
+	assert.True(t, update(m, "$IIALR,161231.00,001,V,A,HIGH WATER*06"))
+	assert.Equal(t, 0, len(m.Alerts()))
+}
@@ -0,0 +1,56 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type ALF struct {
+	nmea.Address
+	NumSentences      int
+	SentenceNumber    int
+	SequentialID      nmea.Optional[int]
+	TimeOfDay         nmea.Optional[nmea.TimeOfDay]
+	Category          nmea.Optional[byte]
+	Priority          nmea.Optional[byte]
+	State             nmea.Optional[byte]
+	ManufacturerCode  string
+	Identifier        nmea.Optional[int]
+	Instance          nmea.Optional[int]
+	RevisionCounter   nmea.Optional[int]
+	EscalationCounter nmea.Optional[int]
+	Text              string
+}
+
+func ParseALF(addr string, tok *nmea.Tokenizer) (*ALF, error) {
+	var alf ALF
+	alf.Address = nmea.NewAddress(addr)
+	alf.NumSentences = tok.CommaUnsignedInt()
+	alf.SentenceNumber = tok.CommaUnsignedInt()
+	alf.SequentialID = tok.CommaOptionalUnsignedInt()
+	alf.TimeOfDay = tok.CommaOptionalTimeOfDay()
+	alf.Category = tok.CommaOptionalOneByteOf("ABC")
+	alf.Priority = tok.CommaOptionalOneByteOf("ACEW")
+	alf.State = tok.CommaOptionalOneByteOf("ANOSUV")
+	alf.ManufacturerCode = tok.CommaString()
+	alf.Identifier = tok.CommaOptionalUnsignedInt()
+	alf.Instance = tok.CommaOptionalUnsignedInt()
+	alf.RevisionCounter = tok.CommaOptionalUnsignedInt()
+	alf.EscalationCounter = tok.CommaOptionalUnsignedInt()
+	alf.Text = tok.CommaString()
+	tok.EndOfData()
+	return &alf, tok.Err()
+}
+
+func (alf *ALF) Encode(b *nmea.Builder) {
+	b.CommaInt(alf.NumSentences)
+	b.CommaInt(alf.SentenceNumber)
+	b.CommaOptionalInt(alf.SequentialID)
+	b.CommaOptionalTimeOfDay(alf.TimeOfDay, 2)
+	b.CommaOptionalByte(alf.Category)
+	b.CommaOptionalByte(alf.Priority)
+	b.CommaOptionalByte(alf.State)
+	b.CommaString(alf.ManufacturerCode)
+	b.CommaOptionalInt(alf.Identifier)
+	b.CommaOptionalInt(alf.Instance)
+	b.CommaOptionalInt(alf.RevisionCounter)
+	b.CommaOptionalInt(alf.EscalationCounter)
+	b.CommaString(alf.Text)
+}
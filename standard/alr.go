@@ -0,0 +1,32 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type ALR struct {
+	nmea.Address
+	TimeOfDay        nmea.Optional[nmea.TimeOfDay]
+	AlarmNumber      int
+	Condition        byte
+	AcknowledgeState byte
+	Description      string
+}
+
+func ParseALR(addr string, tok *nmea.Tokenizer) (*ALR, error) {
+	var alr ALR
+	alr.Address = nmea.NewAddress(addr)
+	alr.TimeOfDay = tok.CommaOptionalTimeOfDay()
+	alr.AlarmNumber = tok.CommaUnsignedInt()
+	alr.Condition = tok.CommaOneByteOf("AV")
+	alr.AcknowledgeState = tok.CommaOneByteOf("AV")
+	alr.Description = tok.CommaString()
+	tok.EndOfData()
+	return &alr, tok.Err()
+}
+
+func (alr *ALR) Encode(b *nmea.Builder) {
+	b.CommaOptionalTimeOfDay(alr.TimeOfDay, 2)
+	b.CommaZeroPaddedInt(alr.AlarmNumber, 3)
+	b.CommaByte(alr.Condition)
+	b.CommaByte(alr.AcknowledgeState)
+	b.CommaString(alr.Description)
+}
@@ -0,0 +1,32 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type ARC struct {
+	nmea.Address
+	TimeOfDay        nmea.Optional[nmea.TimeOfDay]
+	ManufacturerCode string
+	Identifier       int
+	Instance         nmea.Optional[int]
+	Command          byte
+}
+
+func ParseARC(addr string, tok *nmea.Tokenizer) (*ARC, error) {
+	var arc ARC
+	arc.Address = nmea.NewAddress(addr)
+	arc.TimeOfDay = tok.CommaOptionalTimeOfDay()
+	arc.ManufacturerCode = tok.CommaString()
+	arc.Identifier = tok.CommaUnsignedInt()
+	arc.Instance = tok.CommaOptionalUnsignedInt()
+	arc.Command = tok.CommaOneByteOf("AOQS")
+	tok.EndOfData()
+	return &arc, tok.Err()
+}
+
+func (arc *ARC) Encode(b *nmea.Builder) {
+	b.CommaOptionalTimeOfDay(arc.TimeOfDay, 2)
+	b.CommaString(arc.ManufacturerCode)
+	b.CommaInt(arc.Identifier)
+	b.CommaOptionalInt(arc.Instance)
+	b.CommaByte(arc.Command)
+}
@@ -2,6 +2,8 @@ package standard
 
 import "github.com/twpayne/go-nmea"
 
+// A DTM reports the local datum of positions and its offsets from a
+// reference datum. Lat and Lon are in degrees and Alt is in meters.
 type DTM struct {
 	nmea.Address
 	Datum    string
@@ -17,8 +19,8 @@ func ParseDTM(addr string, tok *nmea.Tokenizer) (*DTM, error) {
 	dtm.Address = nmea.NewAddress(addr)
 	dtm.Datum = tok.CommaString()
 	dtm.SubDatum = tok.CommaString()
-	dtm.Lat = 60 * tok.CommaLatCommaHemi()
-	dtm.Lon = 60 * tok.CommaLonCommaHemi()
+	dtm.Lat = tok.CommaLatCommaHemi() / 60
+	dtm.Lon = tok.CommaLonCommaHemi() / 60
 	dtm.Alt = tok.CommaFloat()
 	dtm.RefDatum = tok.CommaString()
 	tok.EndOfData()
@@ -0,0 +1,48 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+// Engine telegraph positions, as used in ETL sentences.
+const (
+	TelegraphPositionStop           = 0
+	TelegraphPositionAheadDeadSlow  = 1
+	TelegraphPositionAheadSlow      = 2
+	TelegraphPositionAheadHalf      = 3
+	TelegraphPositionAheadFull      = 4
+	TelegraphPositionNavFull        = 5
+	TelegraphPositionAsternDeadSlow = 11
+	TelegraphPositionAsternSlow     = 12
+	TelegraphPositionAsternHalf     = 13
+	TelegraphPositionAsternFull     = 14
+	TelegraphPositionCrashAstern    = 15
+)
+
+// Sub-telegraph positions, as used in ETL sentences.
+const (
+	SubTelegraphStandBy            = 20
+	SubTelegraphFullAway           = 30
+	SubTelegraphFinishedWithEngine = 40
+)
+
+type ETL struct {
+	nmea.Address
+	TimeOfDay            nmea.Optional[nmea.TimeOfDay]
+	MessageType          byte
+	TelegraphPosition    nmea.Optional[int]
+	SubTelegraphPosition nmea.Optional[int]
+	OperatingLocation    nmea.Optional[byte]
+	Number               nmea.Optional[int]
+}
+
+func ParseETL(addr string, tok *nmea.Tokenizer) (*ETL, error) {
+	var etl ETL
+	etl.Address = nmea.NewAddress(addr)
+	etl.TimeOfDay = tok.CommaOptionalTimeOfDay()
+	etl.MessageType = tok.CommaOneByteOf("AO")
+	etl.TelegraphPosition = tok.CommaOptionalUnsignedInt()
+	etl.SubTelegraphPosition = tok.CommaOptionalUnsignedInt()
+	etl.OperatingLocation = tok.CommaOptionalOneByteOf("BCEPSW")
+	etl.Number = tok.CommaOptionalUnsignedInt()
+	tok.EndOfData()
+	return &etl, tok.Err()
+}
@@ -18,7 +18,21 @@ type GGA struct {
 
 func ParseGGA(addr string, tok *nmea.Tokenizer) (*GGA, error) {
 	var gga GGA
-	gga.Address = nmea.NewAddress(addr)
+	err := gga.decode(addr, tok)
+	return &gga, err
+}
+
+func (gga *GGA) Decode(addr string, tok *nmea.Tokenizer) error {
+	if err := checkFormatter(addr, "GGA"); err != nil {
+		return err
+	}
+	return gga.decode(addr, tok)
+}
+
+func (gga *GGA) decode(addr string, tok *nmea.Tokenizer) error {
+	*gga = GGA{
+		Address: nmea.NewAddress(addr),
+	}
 	gga.TimeOfDay = tok.CommaOptionalTimeOfDay()
 	gga.Lat = tok.CommaOptionalLatDegMinCommaHemi()
 	gga.Lon = tok.CommaOptionalLonDegMinCommaHemi()
@@ -30,5 +44,22 @@ func ParseGGA(addr string, tok *nmea.Tokenizer) (*GGA, error) {
 	gga.TimeSinceLastDGPSUpdate = tok.CommaOptionalFloat()
 	gga.DGPSReferenceStationID = tok.CommaString()
 	tok.EndOfData()
-	return &gga, tok.Err()
+	return tok.Err()
+}
+
+func (gga *GGA) Encode(b *nmea.Builder) {
+	b.CommaOptionalTimeOfDay(gga.TimeOfDay, 2)
+	b.CommaOptionalLatDegMinCommaHemi(gga.Lat, 5)
+	b.CommaOptionalLonDegMinCommaHemi(gga.Lon, 5)
+	b.CommaInt(gga.FixQuality)
+	if gga.NumberOfSatellites.Valid {
+		b.CommaZeroPaddedInt(gga.NumberOfSatellites.Value, 2)
+	} else {
+		b.Comma()
+	}
+	b.CommaOptionalFloat(gga.HDOP, -1)
+	b.CommaOptionalFloatCommaUnit(gga.Alt, -1, 'M')
+	b.CommaOptionalFloatCommaUnit(gga.HeightOfGeoidAboveWGS84Ellipsoid, -1, 'M')
+	b.CommaOptionalFloat(gga.TimeSinceLastDGPSUpdate, -1)
+	b.CommaString(gga.DGPSReferenceStationID)
 }
@@ -13,11 +13,25 @@ type GLL struct {
 
 func ParseGLL(addr string, tok *nmea.Tokenizer) (*GLL, error) {
 	var gll GLL
-	gll.Address = nmea.NewAddress(addr)
+	err := gll.decode(addr, tok)
+	return &gll, err
+}
+
+func (gll *GLL) Decode(addr string, tok *nmea.Tokenizer) error {
+	if err := checkFormatter(addr, "GLL"); err != nil {
+		return err
+	}
+	return gll.decode(addr, tok)
+}
+
+func (gll *GLL) decode(addr string, tok *nmea.Tokenizer) error {
+	*gll = GLL{
+		Address: nmea.NewAddress(addr),
+	}
 	gll.Lat = tok.CommaOptionalLatDegMinCommaHemi()
 	gll.Lon = tok.CommaOptionalLonDegMinCommaHemi()
 	gll.TimeOfDay = tok.CommaTimeOfDay()
 	gll.Status = tok.CommaOneByteOf("AV")
 	gll.PosMode = tok.CommaOneByteOf("ADEFNR")
-	return &gll, tok.Err()
+	return tok.Err()
 }
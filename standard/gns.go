@@ -19,11 +19,27 @@ type GNS struct {
 
 func ParseGNS(addr string, tok *nmea.Tokenizer) (*GNS, error) {
 	var gns GNS
-	gns.Address = nmea.NewAddress(addr)
+	err := gns.decode(addr, tok)
+	return &gns, err
+}
+
+func (gns *GNS) Decode(addr string, tok *nmea.Tokenizer) error {
+	if err := checkFormatter(addr, "GNS"); err != nil {
+		return err
+	}
+	return gns.decode(addr, tok)
+}
+
+func (gns *GNS) decode(addr string, tok *nmea.Tokenizer) error {
+	*gns = GNS{
+		Address: nmea.NewAddress(addr),
+		PosMode: gns.PosMode[:0],
+	}
 	gns.TimeOfDay = tok.CommaTimeOfDay()
 	gns.Lat = tok.CommaOptionalLatDegMinCommaHemi()
 	gns.Lon = tok.CommaOptionalLonDegMinCommaHemi()
-	gns.PosMode = []byte(tok.CommaString())
+	tok.Comma()
+	gns.PosMode = append(gns.PosMode, tok.Bytes()...)
 	gns.NumSV = tok.CommaUnsignedInt()
 	gns.HDOP = tok.CommaOptionalUnsignedFloat()
 	gns.Alt = tok.CommaOptionalFloat()
@@ -32,5 +48,5 @@ func ParseGNS(addr string, tok *nmea.Tokenizer) (*GNS, error) {
 	gns.DiffStation = tok.CommaOptionalUnsignedInt()
 	gns.NavStatus = tok.CommaOneByteOf("V")
 	tok.EndOfData()
-	return &gns, tok.Err()
+	return tok.Err()
 }
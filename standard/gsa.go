@@ -15,7 +15,22 @@ type GSA struct {
 
 func ParseGSA(addr string, tok *nmea.Tokenizer) (*GSA, error) {
 	var gsa GSA
-	gsa.Address = nmea.NewAddress(addr)
+	err := gsa.decode(addr, tok)
+	return &gsa, err
+}
+
+func (gsa *GSA) Decode(addr string, tok *nmea.Tokenizer) error {
+	if err := checkFormatter(addr, "GSA"); err != nil {
+		return err
+	}
+	return gsa.decode(addr, tok)
+}
+
+func (gsa *GSA) decode(addr string, tok *nmea.Tokenizer) error {
+	*gsa = GSA{
+		Address: nmea.NewAddress(addr),
+		SVIDs:   gsa.SVIDs[:0],
+	}
 	gsa.OpMode = tok.CommaOneByteOf("AM")
 	gsa.NavMode = tok.CommaUnsignedInt()
 	for i := 0; i < 12; i++ {
@@ -29,5 +44,5 @@ func ParseGSA(addr string, tok *nmea.Tokenizer) (*GSA, error) {
 		gsa.SystemID = tok.CommaOptionalUnsignedInt()
 	}
 	tok.EndOfData()
-	return &gsa, tok.Err()
+	return tok.Err()
 }
@@ -20,7 +20,22 @@ type GSV struct {
 
 func ParseGSV(addr string, tok *nmea.Tokenizer) (*GSV, error) {
 	var gsv GSV
-	gsv.Address = nmea.NewAddress(addr)
+	err := gsv.decode(addr, tok)
+	return &gsv, err
+}
+
+func (gsv *GSV) Decode(addr string, tok *nmea.Tokenizer) error {
+	if err := checkFormatter(addr, "GSV"); err != nil {
+		return err
+	}
+	return gsv.decode(addr, tok)
+}
+
+func (gsv *GSV) decode(addr string, tok *nmea.Tokenizer) error {
+	*gsv = GSV{
+		Address:          nmea.NewAddress(addr),
+		SatellitesInView: gsv.SatellitesInView[:0],
+	}
 	gsv.NumMsg = tok.CommaUnsignedInt()
 	gsv.MsgNum = tok.CommaUnsignedInt()
 	gsv.NumSV = tok.CommaUnsignedInt()
@@ -34,18 +49,18 @@ func ParseGSV(addr string, tok *nmea.Tokenizer) (*GSV, error) {
 			gsv.SatellitesInView = append(gsv.SatellitesInView, siv)
 		}
 	} else {
-		tokFork := tok.Fork()
+		saved := *tok
 		tok.Comma()
 		tok.Comma()
 		tok.Comma()
 		tok.Comma()
 		if tok.Err() != nil {
-			tok = tokFork
+			*tok = saved
 		}
 	}
 	if !tok.AtEndOfData() {
-		gsv.SignalID = tok.CommaOptionalUnsignedInt()
+		gsv.SignalID = tok.CommaOptionalHex()
 	}
 	tok.EndOfData()
-	return &gsv, tok.Err()
+	return tok.Err()
 }
@@ -0,0 +1,26 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type HBT struct {
+	nmea.Address
+	RepeatInterval nmea.Optional[float64]
+	Status         byte
+	SequentialID   int
+}
+
+func ParseHBT(addr string, tok *nmea.Tokenizer) (*HBT, error) {
+	var hbt HBT
+	hbt.Address = nmea.NewAddress(addr)
+	hbt.RepeatInterval = tok.CommaOptionalUnsignedFloat()
+	hbt.Status = tok.CommaOneByteOf("AV")
+	hbt.SequentialID = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &hbt, tok.Err()
+}
+
+func (hbt *HBT) Encode(b *nmea.Builder) {
+	b.CommaOptionalFloat(hbt.RepeatInterval, -1)
+	b.CommaByte(hbt.Status)
+	b.CommaInt(hbt.SequentialID)
+}
@@ -0,0 +1,24 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type MWV struct {
+	nmea.Address
+	WindAngle     nmea.Optional[float64]
+	Reference     byte
+	WindSpeed     nmea.Optional[float64]
+	WindSpeedUnit byte
+	Status        byte
+}
+
+func ParseMWV(addr string, tok *nmea.Tokenizer) (*MWV, error) {
+	var mwv MWV
+	mwv.Address = nmea.NewAddress(addr)
+	mwv.WindAngle = tok.CommaOptionalUnsignedFloat()
+	mwv.Reference = tok.CommaOneByteOf("RT")
+	mwv.WindSpeed = tok.CommaOptionalUnsignedFloat()
+	mwv.WindSpeedUnit = tok.CommaOneByteOf("KMNS")
+	mwv.Status = tok.CommaOneByteOf("AV")
+	tok.EndOfData()
+	return &mwv, tok.Err()
+}
@@ -0,0 +1,32 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type OSD struct {
+	nmea.Address
+	HeadingTrue     nmea.Optional[float64]
+	HeadingStatus   byte
+	CourseTrue      nmea.Optional[float64]
+	CourseReference nmea.Optional[byte]
+	Speed           nmea.Optional[float64]
+	SpeedReference  nmea.Optional[byte]
+	SetTrue         nmea.Optional[float64]
+	Drift           nmea.Optional[float64]
+	SpeedUnits      byte
+}
+
+func ParseOSD(addr string, tok *nmea.Tokenizer) (*OSD, error) {
+	var osd OSD
+	osd.Address = nmea.NewAddress(addr)
+	osd.HeadingTrue = tok.CommaOptionalUnsignedFloat()
+	osd.HeadingStatus = tok.CommaOneByteOf("AV")
+	osd.CourseTrue = tok.CommaOptionalUnsignedFloat()
+	osd.CourseReference = tok.CommaOptionalOneByteOf("BMPRW")
+	osd.Speed = tok.CommaOptionalUnsignedFloat()
+	osd.SpeedReference = tok.CommaOptionalOneByteOf("BMPRW")
+	osd.SetTrue = tok.CommaOptionalUnsignedFloat()
+	osd.Drift = tok.CommaOptionalUnsignedFloat()
+	osd.SpeedUnits = tok.CommaOneByteOf("KNS")
+	tok.EndOfData()
+	return &osd, tok.Err()
+}
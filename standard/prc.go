@@ -0,0 +1,30 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type PRC struct {
+	nmea.Address
+	LeverDemand       nmea.Optional[float64]
+	LeverDemandStatus byte
+	RPMDemand         nmea.Optional[float64]
+	RPMMode           byte
+	PitchDemand       nmea.Optional[float64]
+	PitchMode         byte
+	OperatingLocation nmea.Optional[byte]
+	Number            nmea.Optional[int]
+}
+
+func ParsePRC(addr string, tok *nmea.Tokenizer) (*PRC, error) {
+	var prc PRC
+	prc.Address = nmea.NewAddress(addr)
+	prc.LeverDemand = tok.CommaOptionalFloat()
+	prc.LeverDemandStatus = tok.CommaOneByteOf("AV")
+	prc.RPMDemand = tok.CommaOptionalFloat()
+	prc.RPMMode = tok.CommaOneByteOf("PRV")
+	prc.PitchDemand = tok.CommaOptionalFloat()
+	prc.PitchMode = tok.CommaOneByteOf("DPV")
+	prc.OperatingLocation = tok.CommaOptionalOneByteOf("BCEPSW")
+	prc.Number = tok.CommaOptionalUnsignedInt()
+	tok.EndOfData()
+	return &prc, tok.Err()
+}
@@ -0,0 +1,50 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+// A Query is a query sentence, for example $CCGPQ,GGA, which requests that
+// the device with the target talker ID emits the sentence with the requested
+// formatter. The address of a query sentence is the requester's talker ID,
+// the target's talker ID, and Q. Proprietary addresses, which start with P,
+// are not queries.
+//
+// nmea.Address assumes a two-character talker ID, so the Formatter method of
+// a Query's address returns the target's talker ID and Q, for example GPQ,
+// rather than Q. Use a type switch on *Query to recognize queries, and the
+// Requester, Target, and RequestedFormatter fields to inspect them.
+type Query struct {
+	nmea.Address
+	Requester          string
+	Target             string
+	RequestedFormatter string
+}
+
+// NewQuery returns a new Query from requester to target for
+// requestedFormatter.
+func NewQuery(requester, target, requestedFormatter string) *Query {
+	return &Query{
+		Address:            nmea.NewAddress(requester + target + "Q"),
+		Requester:          requester,
+		Target:             target,
+		RequestedFormatter: requestedFormatter,
+	}
+}
+
+func ParseQuery(addr string, tok *nmea.Tokenizer) (*Query, error) {
+	if len(addr) != 5 || addr[0] == 'P' || addr[4] != 'Q' {
+		return nil, &nmea.UnexpectedAddressError{
+			Address: addr,
+		}
+	}
+	var query Query
+	query.Address = nmea.NewAddress(addr)
+	query.Requester = addr[:2]
+	query.Target = addr[2:4]
+	query.RequestedFormatter = tok.CommaString()
+	tok.EndOfData()
+	return &query, tok.Err()
+}
+
+func (query *Query) Encode(b *nmea.Builder) {
+	b.CommaString(query.RequestedFormatter)
+}
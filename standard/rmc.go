@@ -22,7 +22,21 @@ type RMC struct {
 
 func ParseRMC(addr string, tok *nmea.Tokenizer) (*RMC, error) {
 	var rmc RMC
-	rmc.Address = nmea.NewAddress(addr)
+	err := rmc.decode(addr, tok)
+	return &rmc, err
+}
+
+func (rmc *RMC) Decode(addr string, tok *nmea.Tokenizer) error {
+	if err := checkFormatter(addr, "RMC"); err != nil {
+		return err
+	}
+	return rmc.decode(addr, tok)
+}
+
+func (rmc *RMC) decode(addr string, tok *nmea.Tokenizer) error {
+	*rmc = RMC{
+		Address: nmea.NewAddress(addr),
+	}
 	rmc.TimeOfDay = tok.CommaOptionalTimeOfDay()
 	rmc.Status = tok.CommaOneByteOf("AV")
 	rmc.Lat = tok.CommaOptionalLatDegMinCommaHemi()
@@ -46,18 +60,12 @@ func ParseRMC(addr string, tok *nmea.Tokenizer) (*RMC, error) {
 		rmc.NavStatus = nmea.NewOptional(tok.CommaOneByteOf("V"))
 	}
 	tok.EndOfData()
-	return &rmc, tok.Err()
+	return tok.Err()
 }
 
 func (rmc *RMC) Time() nmea.Optional[time.Time] {
 	if !rmc.TimeOfDay.Valid || !rmc.Date.Valid {
 		return nmea.Optional[time.Time]{}
 	}
-	timeOfDay := rmc.TimeOfDay.Value
-	date := rmc.Date.Value
-	return nmea.NewOptional(time.Date(
-		date.Year, date.Month, date.Day,
-		timeOfDay.Hour, timeOfDay.Minute, timeOfDay.Second, timeOfDay.Nanosecond,
-		time.UTC,
-	))
+	return nmea.NewOptional(rmc.Date.Value.At(rmc.TimeOfDay.Value))
 }
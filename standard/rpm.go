@@ -0,0 +1,24 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type RPM struct {
+	nmea.Address
+	Source byte
+	Number int
+	Speed  nmea.Optional[float64]
+	Pitch  nmea.Optional[float64]
+	Status byte
+}
+
+func ParseRPM(addr string, tok *nmea.Tokenizer) (*RPM, error) {
+	var rpm RPM
+	rpm.Address = nmea.NewAddress(addr)
+	rpm.Source = tok.CommaOneByteOf("ES")
+	rpm.Number = tok.CommaUnsignedInt()
+	rpm.Speed = tok.CommaOptionalFloat()
+	rpm.Pitch = tok.CommaOptionalFloat()
+	rpm.Status = tok.CommaOneByteOf("AV")
+	tok.EndOfData()
+	return &rpm, tok.Err()
+}
@@ -0,0 +1,22 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type RSA struct {
+	nmea.Address
+	StarboardRudderAngle  nmea.Optional[float64]
+	StarboardRudderStatus byte
+	PortRudderAngle       nmea.Optional[float64]
+	PortRudderStatus      byte
+}
+
+func ParseRSA(addr string, tok *nmea.Tokenizer) (*RSA, error) {
+	var rsa RSA
+	rsa.Address = nmea.NewAddress(addr)
+	rsa.StarboardRudderAngle = tok.CommaOptionalFloat()
+	rsa.StarboardRudderStatus = tok.CommaOneByteOf("AV")
+	rsa.PortRudderAngle = tok.CommaOptionalFloat()
+	rsa.PortRudderStatus = tok.CommaOneByteOf("AV")
+	tok.EndOfData()
+	return &rsa, tok.Err()
+}
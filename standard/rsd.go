@@ -0,0 +1,40 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type RSD struct {
+	nmea.Address
+	Origin1Range    nmea.Optional[float64]
+	Origin1Bearing  nmea.Optional[float64]
+	VRM1            nmea.Optional[float64]
+	EBL1            nmea.Optional[float64]
+	Origin2Range    nmea.Optional[float64]
+	Origin2Bearing  nmea.Optional[float64]
+	VRM2            nmea.Optional[float64]
+	EBL2            nmea.Optional[float64]
+	CursorRange     nmea.Optional[float64]
+	CursorBearing   nmea.Optional[float64]
+	RangeScale      nmea.Optional[float64]
+	RangeUnits      byte
+	DisplayRotation byte
+}
+
+func ParseRSD(addr string, tok *nmea.Tokenizer) (*RSD, error) {
+	var rsd RSD
+	rsd.Address = nmea.NewAddress(addr)
+	rsd.Origin1Range = tok.CommaOptionalUnsignedFloat()
+	rsd.Origin1Bearing = tok.CommaOptionalUnsignedFloat()
+	rsd.VRM1 = tok.CommaOptionalUnsignedFloat()
+	rsd.EBL1 = tok.CommaOptionalUnsignedFloat()
+	rsd.Origin2Range = tok.CommaOptionalUnsignedFloat()
+	rsd.Origin2Bearing = tok.CommaOptionalUnsignedFloat()
+	rsd.VRM2 = tok.CommaOptionalUnsignedFloat()
+	rsd.EBL2 = tok.CommaOptionalUnsignedFloat()
+	rsd.CursorRange = tok.CommaOptionalUnsignedFloat()
+	rsd.CursorBearing = tok.CommaOptionalUnsignedFloat()
+	rsd.RangeScale = tok.CommaOptionalUnsignedFloat()
+	rsd.RangeUnits = tok.CommaOneByteOf("KNS")
+	rsd.DisplayRotation = tok.CommaOneByteOf("CHN")
+	tok.EndOfData()
+	return &rsd, tok.Err()
+}
@@ -0,0 +1,143 @@
+package standard
+
+import (
+	"fmt"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// A Satellite is a canonical satellite identifier. PRN is the satellite's
+// PRN within its constellation, except for GLONASS where it is the slot
+// number.
+type Satellite struct {
+	Constellation Constellation
+	PRN           int
+}
+
+// String returns s in RINEX format, for example G01, R07, or S20.
+func (s Satellite) String() string {
+	switch s.Constellation {
+	case ConstellationGPS:
+		return fmt.Sprintf("G%02d", s.PRN)
+	case ConstellationGLONASS:
+		return fmt.Sprintf("R%02d", s.PRN)
+	case ConstellationGalileo:
+		return fmt.Sprintf("E%02d", s.PRN)
+	case ConstellationBeiDou:
+		return fmt.Sprintf("C%02d", s.PRN)
+	case ConstellationQZSS:
+		return fmt.Sprintf("J%02d", s.PRN)
+	case ConstellationNavIC:
+		return fmt.Sprintf("I%02d", s.PRN)
+	case ConstellationSBAS:
+		return fmt.Sprintf("S%02d", s.PRN-100)
+	default:
+		return fmt.Sprintf("?%02d", s.PRN)
+	}
+}
+
+// NormalizeSVID returns the canonical satellite for svid in a sentence with
+// talker and optional systemID.
+//
+// When the talker or system ID identifies a single constellation, both the
+// NMEA 4.11 numbering, where IDs are PRNs within the constellation, and the
+// extended numberings used by receivers with NMEA 4.10 and earlier are
+// accepted. Otherwise, for the GP and GN talkers, the satellite is
+// identified from the extended numbering alone:
+//
+//	1-32    GPS
+//	33-64   SBAS PRN 120-151
+//	65-96   GLONASS slots 1-32
+//	120-158 SBAS
+//	193-200 QZSS PRN 1-8
+//	201-263 BeiDou PRN 1-63
+//	301-336 Galileo PRN 1-36
+//	401-463 BeiDou PRN 1-63
+func NormalizeSVID(talker Talker, systemID nmea.Optional[int], svid int) (Satellite, bool) {
+	constellation := ConstellationOf(talker, systemID)
+	switch constellation {
+	case ConstellationGLONASS:
+		switch {
+		case 1 <= svid && svid <= 32:
+			return Satellite{ConstellationGLONASS, svid}, true
+		case 65 <= svid && svid <= 96:
+			return Satellite{ConstellationGLONASS, svid - 64}, true
+		}
+	case ConstellationGalileo:
+		switch {
+		case 1 <= svid && svid <= 36:
+			return Satellite{ConstellationGalileo, svid}, true
+		case 301 <= svid && svid <= 336:
+			return Satellite{ConstellationGalileo, svid - 300}, true
+		}
+	case ConstellationBeiDou:
+		switch {
+		case 1 <= svid && svid <= 63:
+			return Satellite{ConstellationBeiDou, svid}, true
+		case 201 <= svid && svid <= 263:
+			return Satellite{ConstellationBeiDou, svid - 200}, true
+		case 401 <= svid && svid <= 463:
+			return Satellite{ConstellationBeiDou, svid - 400}, true
+		}
+	case ConstellationQZSS:
+		switch {
+		case 1 <= svid && svid <= 10:
+			return Satellite{ConstellationQZSS, svid}, true
+		case 193 <= svid && svid <= 202:
+			return Satellite{ConstellationQZSS, svid - 192}, true
+		}
+	case ConstellationNavIC:
+		if 1 <= svid && svid <= 14 {
+			return Satellite{ConstellationNavIC, svid}, true
+		}
+	case ConstellationGPS, ConstellationUnknown:
+		switch {
+		case 1 <= svid && svid <= 32:
+			return Satellite{ConstellationGPS, svid}, true
+		case 33 <= svid && svid <= 64:
+			return Satellite{ConstellationSBAS, svid + 87}, true
+		case 65 <= svid && svid <= 96:
+			return Satellite{ConstellationGLONASS, svid - 64}, true
+		case 120 <= svid && svid <= 158:
+			return Satellite{ConstellationSBAS, svid}, true
+		case 193 <= svid && svid <= 200:
+			return Satellite{ConstellationQZSS, svid - 192}, true
+		case 201 <= svid && svid <= 263:
+			return Satellite{ConstellationBeiDou, svid - 200}, true
+		case 301 <= svid && svid <= 336:
+			return Satellite{ConstellationGalileo, svid - 300}, true
+		case 401 <= svid && svid <= 463:
+			return Satellite{ConstellationBeiDou, svid - 400}, true
+		}
+	}
+	return Satellite{}, false
+}
+
+// Satellites returns the canonical satellites used in the solution in gsa,
+// skipping empty fields. Satellites whose IDs cannot be normalized are
+// returned as the zero Satellite.
+func (gsa *GSA) Satellites() []Satellite {
+	talker := TalkerOf(gsa.Address)
+	var satellites []Satellite
+	for _, svid := range gsa.SVIDs {
+		if !svid.Valid {
+			continue
+		}
+		satellite, _ := NormalizeSVID(talker, gsa.SystemID, svid.Value)
+		satellites = append(satellites, satellite)
+	}
+	return satellites
+}
+
+// Satellites returns the canonical satellites in view in gsv, in the same
+// order as gsv.SatellitesInView. Satellites whose IDs cannot be normalized
+// are returned as the zero Satellite.
+func (gsv *GSV) Satellites() []Satellite {
+	talker := TalkerOf(gsv.Address)
+	satellites := make([]Satellite, 0, len(gsv.SatellitesInView))
+	for _, satelliteInView := range gsv.SatellitesInView {
+		satellite, _ := NormalizeSVID(talker, nmea.Optional[int]{}, satelliteInView.SVID)
+		satellites = append(satellites, satellite)
+	}
+	return satellites
+}
@@ -0,0 +1,72 @@
+package standard_test
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+func TestNormalizeSVID(t *testing.T) {
+	for _, tc := range []struct {
+		talker     standard.Talker
+		systemID   nmea.Optional[int]
+		svid       int
+		expectedOK bool
+		expected   string
+	}{
+		{talker: "GP", svid: 7, expectedOK: true, expected: "G07"},
+		{talker: "GP", svid: 46, expectedOK: true, expected: "S33"},
+		{talker: "GP", svid: 131, expectedOK: true, expected: "S31"},
+		{talker: "GP", svid: 193, expectedOK: true, expected: "J01"},
+		{talker: "GP", svid: 99},
+		{talker: "GN", svid: 70, expectedOK: true, expected: "R06"},
+		{talker: "GN", svid: 214, expectedOK: true, expected: "C14"},
+		{talker: "GN", svid: 311, expectedOK: true, expected: "E11"},
+		{talker: "GN", svid: 420, expectedOK: true, expected: "C20"},
+		{talker: "GN", systemID: nmea.NewOptional(3), svid: 11, expectedOK: true, expected: "E11"},
+		{talker: "GN", systemID: nmea.NewOptional(4), svid: 46, expectedOK: true, expected: "C46"},
+		{talker: "GN", systemID: nmea.NewOptional(5), svid: 3, expectedOK: true, expected: "J03"},
+		{talker: "GL", svid: 65, expectedOK: true, expected: "R01"},
+		{talker: "GL", svid: 5, expectedOK: true, expected: "R05"},
+		{talker: "GA", svid: 301, expectedOK: true, expected: "E01"},
+		{talker: "GA", svid: 37},
+		{talker: "GB", svid: 201, expectedOK: true, expected: "C01"},
+		{talker: "BD", svid: 437, expectedOK: true, expected: "C37"},
+		{talker: "GQ", svid: 194, expectedOK: true, expected: "J02"},
+		{talker: "GI", svid: 3, expectedOK: true, expected: "I03"},
+	} {
+		t.Run(tc.expected, func(t *testing.T) {
+			satellite, ok := standard.NormalizeSVID(tc.talker, tc.systemID, tc.svid)
+			assert.Equal(t, tc.expectedOK, ok)
+			if tc.expectedOK {
+				assert.Equal(t, tc.expected, satellite.String())
+			}
+		})
+	}
+}
+
+func TestSentenceSatellites(t *testing.T) {
+	parser := nmea.NewParser(
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+		nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+	)
+
+	sentence, err := parser.ParseString("$GNGSA,A,3,80,71,73,79,69,,,,,,,,1.83,1.09,1.47,2*09")
+	assert.NoError(t, err)
+	assert.Equal(t, []standard.Satellite{
+		{Constellation: standard.ConstellationGLONASS, PRN: 16},
+		{Constellation: standard.ConstellationGLONASS, PRN: 7},
+		{Constellation: standard.ConstellationGLONASS, PRN: 9},
+		{Constellation: standard.ConstellationGLONASS, PRN: 15},
+		{Constellation: standard.ConstellationGLONASS, PRN: 5},
+	}, sentence.(*standard.GSA).Satellites())
+
+	sentence, err = parser.ParseString("$GBGSV,1,1,01,19,40,297,42,B*32")
+	assert.NoError(t, err)
+	assert.Equal(t, []standard.Satellite{
+		{Constellation: standard.ConstellationBeiDou, PRN: 19},
+	}, sentence.(*standard.GSV).Satellites())
+}
@@ -0,0 +1,178 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+// A Constellation is a GNSS constellation.
+type Constellation int
+
+// Constellations. The values of the constellations with system IDs are
+// their NMEA 0183 system IDs. SBAS does not have a system ID.
+const (
+	ConstellationUnknown Constellation = 0
+	ConstellationGPS     Constellation = 1
+	ConstellationGLONASS Constellation = 2
+	ConstellationGalileo Constellation = 3
+	ConstellationBeiDou  Constellation = 4
+	ConstellationQZSS    Constellation = 5
+	ConstellationNavIC   Constellation = 6
+	ConstellationSBAS    Constellation = 7
+)
+
+// ConstellationOfSystemID returns the constellation with systemID, as used
+// in GBS, GNS, GRS, and GSA sentences.
+func ConstellationOfSystemID(systemID int) Constellation {
+	if systemID < int(ConstellationGPS) || int(ConstellationNavIC) < systemID {
+		return ConstellationUnknown
+	}
+	return Constellation(systemID)
+}
+
+func (c Constellation) String() string {
+	switch c {
+	case ConstellationGPS:
+		return "GPS"
+	case ConstellationGLONASS:
+		return "GLONASS"
+	case ConstellationGalileo:
+		return "Galileo"
+	case ConstellationBeiDou:
+		return "BeiDou"
+	case ConstellationQZSS:
+		return "QZSS"
+	case ConstellationNavIC:
+		return "NavIC"
+	case ConstellationSBAS:
+		return "SBAS"
+	default:
+		return "unknown"
+	}
+}
+
+// A Signal is a GNSS signal.
+type Signal struct {
+	Constellation Constellation
+	ID            int
+	Band          string
+}
+
+func (s Signal) String() string {
+	return s.Constellation.String() + " " + s.Band
+}
+
+// signalBands maps constellations and NMEA 0183 signal IDs to signal bands.
+var signalBands = map[Constellation]map[int]string{
+	ConstellationGPS: {
+		1: "L1 C/A",
+		2: "L1 P(Y)",
+		3: "L1 M",
+		4: "L2 P(Y)",
+		5: "L2C-M",
+		6: "L2C-L",
+		7: "L5-I",
+		8: "L5-Q",
+	},
+	ConstellationGLONASS: {
+		1: "G1 C/A",
+		2: "G1 P",
+		3: "G2 C/A",
+		4: "G2 P",
+	},
+	ConstellationGalileo: {
+		1: "E5a",
+		2: "E5b",
+		3: "E5 AltBOC",
+		4: "E6-A",
+		5: "E6-BC",
+		6: "E1-A",
+		7: "E1-BC",
+	},
+	ConstellationBeiDou: {
+		1:  "B1I",
+		2:  "B1Q",
+		3:  "B1C",
+		4:  "B1A",
+		5:  "B2a",
+		6:  "B2b",
+		7:  "B2a+b",
+		8:  "B3I",
+		9:  "B3Q",
+		10: "B3A",
+		11: "B2I",
+		12: "B2Q",
+	},
+	ConstellationQZSS: {
+		1:  "L1 C/A",
+		2:  "L1C (D)",
+		3:  "L1C (P)",
+		4:  "LIS",
+		5:  "L2C-M",
+		6:  "L2C-L",
+		7:  "L5-I",
+		8:  "L5-Q",
+		9:  "L6D",
+		10: "L6E",
+	},
+	ConstellationNavIC: {
+		1: "L5-SPS",
+		2: "S-SPS",
+		3: "L5-RS",
+		4: "S-RS",
+		5: "L1-SPS",
+	},
+}
+
+// ConstellationOf returns the constellation of a sentence with talker and
+// optional systemID. The system ID takes precedence over the talker, which
+// is necessary for the GN talker.
+func ConstellationOf(talker Talker, systemID nmea.Optional[int]) Constellation {
+	if systemID.Valid {
+		if constellation := ConstellationOfSystemID(systemID.Value); constellation != ConstellationUnknown {
+			return constellation
+		}
+	}
+	return talker.Constellation()
+}
+
+// SignalOf returns the signal of a sentence with talker, optional systemID,
+// and signalID, as used in GBS, GRS, and GSV sentences.
+func SignalOf(talker Talker, systemID nmea.Optional[int], signalID int) (Signal, bool) {
+	constellation := ConstellationOf(talker, systemID)
+	band, ok := signalBands[constellation][signalID]
+	if !ok {
+		return Signal{}, false
+	}
+	return Signal{
+		Constellation: constellation,
+		ID:            signalID,
+		Band:          band,
+	}, true
+}
+
+// Constellation returns the constellation of the satellites in gsa.
+func (gsa *GSA) Constellation() Constellation {
+	return ConstellationOf(TalkerOf(gsa.Address), gsa.SystemID)
+}
+
+// Signal returns the signal of the satellites in gsv.
+func (gsv *GSV) Signal() (Signal, bool) {
+	if !gsv.SignalID.Valid {
+		return Signal{}, false
+	}
+	return SignalOf(TalkerOf(gsv.Address), nmea.Optional[int]{}, gsv.SignalID.Value)
+}
+
+// Signal returns the signal used to compute gbs.
+func (gbs *GBS) Signal() (Signal, bool) {
+	if !gbs.SignalID.Valid {
+		return Signal{}, false
+	}
+	return SignalOf(TalkerOf(gbs.Address), gbs.SystemID, gbs.SignalID.Value)
+}
+
+// Signal returns the signal used to compute grs.
+func (grs *GRS) Signal() (Signal, bool) {
+	if !grs.SignalID.Valid {
+		return Signal{}, false
+	}
+	return SignalOf(TalkerOf(grs.Address), grs.SystemID, grs.SignalID.Value)
+}
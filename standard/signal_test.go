@@ -0,0 +1,144 @@
+package standard_test
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/standard"
+)
+
+func TestTalker(t *testing.T) {
+	for _, tc := range []struct {
+		talker                standard.Talker
+		expectedKnown         bool
+		expectedName          string
+		expectedConstellation standard.Constellation
+	}{
+		{
+			talker:                standard.TalkerGPS,
+			expectedKnown:         true,
+			expectedName:          "GPS, SBAS, QZSS",
+			expectedConstellation: standard.ConstellationGPS,
+		},
+		{
+			talker:                "BD",
+			expectedKnown:         true,
+			expectedName:          "BeiDou",
+			expectedConstellation: standard.ConstellationBeiDou,
+		},
+		{
+			talker:                "GI",
+			expectedKnown:         true,
+			expectedName:          "NavIC",
+			expectedConstellation: standard.ConstellationNavIC,
+		},
+		{
+			talker:        "GN",
+			expectedKnown: true,
+			expectedName:  "Any combination of GNSS",
+		},
+		{
+			talker:        "II",
+			expectedKnown: true,
+			expectedName:  "Integrated instrumentation",
+		},
+		{
+			talker:        "U3",
+			expectedKnown: true,
+			expectedName:  "User configured",
+		},
+		{
+			talker: "XX",
+		},
+	} {
+		t.Run(string(tc.talker), func(t *testing.T) {
+			assert.Equal(t, tc.expectedKnown, tc.talker.Known())
+			assert.Equal(t, tc.expectedName, tc.talker.Name())
+			assert.Equal(t, tc.expectedConstellation, tc.talker.Constellation())
+		})
+	}
+
+	assert.Equal(t, "Weather instruments", standard.TalkerNames["WI"])
+	assert.Equal(t, standard.TalkerGPS, standard.TalkerOf(nmea.NewAddress("GPGGA")))
+}
+
+func TestSignalOf(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		talker         standard.Talker
+		systemID       nmea.Optional[int]
+		signalID       int
+		expectedOK     bool
+		expectedString string
+	}{
+		{
+			name:           "gps_l1_ca",
+			talker:         standard.TalkerGPS,
+			signalID:       1,
+			expectedOK:     true,
+			expectedString: "GPS L1 C/A",
+		},
+		{
+			name:           "gn_galileo_e1",
+			talker:         standard.TalkerGNSS,
+			systemID:       nmea.NewOptional(3),
+			signalID:       7,
+			expectedOK:     true,
+			expectedString: "Galileo E1-BC",
+		},
+		{
+			name:           "beidou_b2i",
+			talker:         standard.TalkerBeiDou,
+			signalID:       0xb,
+			expectedOK:     true,
+			expectedString: "BeiDou B2I",
+		},
+		{
+			name:     "gn_without_system_id",
+			talker:   standard.TalkerGNSS,
+			signalID: 1,
+		},
+		{
+			name:     "unknown_signal_id",
+			talker:   standard.TalkerGLONASS,
+			signalID: 9,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			signal, ok := standard.SignalOf(tc.talker, tc.systemID, tc.signalID)
+			assert.Equal(t, tc.expectedOK, ok)
+			if tc.expectedOK {
+				assert.Equal(t, tc.expectedString, signal.String())
+			}
+		})
+	}
+}
+
+func TestSentenceSignals(t *testing.T) {
+	parser := nmea.NewParser(
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+		nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+	)
+
+	sentence, err := parser.ParseString("$GBGSV,1,1,01,19,40,297,42,B*32")
+	assert.NoError(t, err)
+	signal, ok := sentence.(*standard.GSV).Signal()
+	assert.True(t, ok)
+	assert.Equal(t, standard.Signal{
+		Constellation: standard.ConstellationBeiDou,
+		ID:            0xb,
+		Band:          "B2I",
+	}, signal)
+
+	sentence, err = parser.ParseString("$GNGBS,235458.00,1.4,1.3,3.1,03,,-21.4,3.8,1,1*45")
+	assert.NoError(t, err)
+	signal, ok = sentence.(*standard.GBS).Signal()
+	assert.True(t, ok)
+	assert.Equal(t, "GPS L1 C/A", signal.String())
+
+	sentence, err = parser.ParseString("$GNGSA,A,3,80,71,73,79,69,,,,,,,,1.83,1.09,1.47,2*09")
+	assert.NoError(t, err)
+	assert.Equal(t, standard.ConstellationGLONASS, sentence.(*standard.GSA).Constellation())
+}
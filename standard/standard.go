@@ -2,27 +2,33 @@
 package standard
 
 import (
-	"regexp"
-
 	"github.com/twpayne/go-nmea"
 )
 
 var (
-	TalkerNames = map[string]string{
-		"GA": "Galileo",
-		"GB": "BeiDou",
-		"GL": "GLONASS",
-		"GN": "Any combination of GNSS",
-		"GP": "GPS, SBAS, QZSS",
-	}
+	// TalkerNames maps talker IDs to their names.
+	TalkerNames = func() map[string]string {
+		talkerNamesByString := make(map[string]string, len(talkerNames))
+		for talker, name := range talkerNames {
+			talkerNamesByString[string(talker)] = name
+		}
+		return talkerNamesByString
+	}()
 
-	addressRx = regexp.MustCompile(`\A[A-Z]{2}([A-Z]{3})\z`)
+	queryParser = nmea.MakeSentenceParser(ParseQuery)
 
 	sentenceParserMap = nmea.SentenceParserMap{
+		"ACK": nmea.MakeSentenceParser(ParseACK),
+		"ACN": nmea.MakeSentenceParser(ParseACN),
+		"ALC": nmea.MakeSentenceParser(ParseALC),
+		"ALF": nmea.MakeSentenceParser(ParseALF),
 		"ALM": nmea.MakeSentenceParser(ParseALM),
+		"ALR": nmea.MakeSentenceParser(ParseALR),
+		"ARC": nmea.MakeSentenceParser(ParseARC),
 		"DBT": nmea.MakeSentenceParser(ParseDBT),
 		"DPT": nmea.MakeSentenceParser(ParseDPT),
 		"DTM": nmea.MakeSentenceParser(ParseDTM),
+		"ETL": nmea.MakeSentenceParser(ParseETL),
 		"GBS": nmea.MakeSentenceParser(ParseGBS),
 		"GGA": nmea.MakeSentenceParser(ParseGGA),
 		"GLL": nmea.MakeSentenceParser(ParseGLL),
@@ -31,27 +37,57 @@ var (
 		"GSA": nmea.MakeSentenceParser(ParseGSA),
 		"GST": nmea.MakeSentenceParser(ParseGST),
 		"GSV": nmea.MakeSentenceParser(ParseGSV),
+		"HBT": nmea.MakeSentenceParser(ParseHBT),
 		"HDT": nmea.MakeSentenceParser(ParseHDT),
 		"MLA": nmea.MakeSentenceParser(ParseMLA),
 		"MSS": nmea.MakeSentenceParser(ParseMSS),
 		"MTW": nmea.MakeSentenceParser(ParseMTW),
+		"MWV": nmea.MakeSentenceParser(ParseMWV),
+		"OSD": nmea.MakeSentenceParser(ParseOSD),
+		"PRC": nmea.MakeSentenceParser(ParsePRC),
 		"RMB": nmea.MakeSentenceParser(ParseRMB),
 		"RMC": nmea.MakeSentenceParser(ParseRMC),
+		"RPM": nmea.MakeSentenceParser(ParseRPM),
+		"RSA": nmea.MakeSentenceParser(ParseRSA),
+		"RSD": nmea.MakeSentenceParser(ParseRSD),
 		"THS": nmea.MakeSentenceParser(ParseTHS),
+		"TLB": nmea.MakeSentenceParser(ParseTLB),
+		"TLL": nmea.MakeSentenceParser(ParseTLL),
+		"TRC": nmea.MakeSentenceParser(ParseTRC),
+		"TRD": nmea.MakeSentenceParser(ParseTRD),
+		"TTM": nmea.MakeSentenceParser(ParseTTM),
 		"TXT": nmea.MakeSentenceParser(ParseTXT),
 		"VHW": nmea.MakeSentenceParser(ParseVHW),
 		"VLW": nmea.MakeSentenceParser(ParseVLW),
 		"VTG": nmea.MakeSentenceParser(ParseVTG),
+		"XDR": nmea.MakeSentenceParser(ParseXDR),
 		"ZDA": nmea.MakeSentenceParser(ParseZDA),
 	}
 )
 
+func checkFormatter(addr, formatter string) error {
+	if len(addr) != 2+len(formatter) || addr[2:] != formatter {
+		return &nmea.UnexpectedAddressError{
+			Address: addr,
+		}
+	}
+	return nil
+}
+
 func SentenceParserFunc(addr string) nmea.SentenceParser {
-	match := addressRx.FindStringSubmatch(addr)
-	if match != nil {
-		if sentenceParser := sentenceParserMap[match[1]]; sentenceParser != nil {
-			return sentenceParser
+	if len(addr) != 5 {
+		return nil
+	}
+	for i := 0; i < len(addr); i++ {
+		if addr[i] < 'A' || 'Z' < addr[i] {
+			return nil
 		}
 	}
+	if sentenceParser, ok := sentenceParserMap[addr[2:]]; ok {
+		return sentenceParser
+	}
+	if addr[0] != 'P' && addr[4] == 'Q' {
+		return queryParser
+	}
 	return nil
 }
@@ -1,9 +1,12 @@
 package standard_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/alecthomas/assert/v2"
+
 	"github.com/twpayne/go-nmea"
 	"github.com/twpayne/go-nmea/nmeatest"
 	"github.com/twpayne/go-nmea/standard"
@@ -928,6 +931,26 @@ func TestMiscellaneous(t *testing.T) {
 			nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
 		},
 		[]nmeatest.TestCase{
+			{
+				S: "$GPDTM,999,,0.08,N,0.07,E,-47.7,W84*1B",
+				Expected: &standard.DTM{
+					Address:  nmea.NewAddress("GPDTM"),
+					Datum:    "999",
+					Lat:      0.08 / 60,
+					Lon:      0.07 / 60,
+					Alt:      -47.7,
+					RefDatum: "W84",
+				},
+			},
+			{
+				S: "$GPZDA,235960.50,31,12,2016,00,00*6C",
+				Expected: &standard.ZDA{
+					Address:              nmea.NewAddress("GPZDA"),
+					Time:                 time.Date(2016, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+					LocalTimeZoneHours:   nmea.NewOptional(0),
+					LocalTimeZoneMinutes: nmea.NewOptional(0),
+				},
+			},
 			{
 				S: "$GPGGA,102039.00,,,,,0,00,99.99,,,,,,*6F",
 				Expected: &standard.GGA{
@@ -1093,6 +1116,483 @@ func TestMiscellaneous(t *testing.T) {
 	)
 }
 
+func TestRadar(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$RAOSD,35.1,A,36.0,P,10.2,P,15.3,0.1,N*41",
+				Expected: &standard.OSD{
+					Address:         nmea.NewAddress("RAOSD"),
+					HeadingTrue:     nmea.NewOptional(35.1),
+					HeadingStatus:   'A',
+					CourseTrue:      nmea.NewOptional(36.0),
+					CourseReference: nmea.NewOptional[byte]('P'),
+					Speed:           nmea.NewOptional(10.2),
+					SpeedReference:  nmea.NewOptional[byte]('P'),
+					SetTrue:         nmea.NewOptional(15.3),
+					Drift:           nmea.NewOptional(0.1),
+					SpeedUnits:      'N',
+				},
+			},
+			{
+				S: "$RARSD,12.0,90.0,2.5,45.0,,,,,3.1,270.0,6.0,N,H*6F",
+				Expected: &standard.RSD{
+					Address:         nmea.NewAddress("RARSD"),
+					Origin1Range:    nmea.NewOptional(12.0),
+					Origin1Bearing:  nmea.NewOptional(90.0),
+					VRM1:            nmea.NewOptional(2.5),
+					EBL1:            nmea.NewOptional(45.0),
+					CursorRange:     nmea.NewOptional(3.1),
+					CursorBearing:   nmea.NewOptional(270.0),
+					RangeScale:      nmea.NewOptional(6.0),
+					RangeUnits:      'N',
+					DisplayRotation: 'H',
+				},
+			},
+			{
+				S: "$RATLB,1,TARGET1,2,TARGET2*49",
+				Expected: &standard.TLB{
+					Address: nmea.NewAddress("RATLB"),
+					TargetLabels: []standard.TargetLabel{
+						{TargetNumber: 1, Label: "TARGET1"},
+						{TargetNumber: 2, Label: "TARGET2"},
+					},
+				},
+			},
+			{
+				S: "$RATLL,01,3646.54,N,00235.37,W,TARGET1,161229.00,T,*10",
+				Expected: &standard.TLL{
+					Address:      nmea.NewAddress("RATLL"),
+					TargetNumber: 1,
+					Lat:          36.775666666666666,
+					Lon:          -2.5895,
+					TargetName:   "TARGET1",
+					TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+						Hour:   16,
+						Minute: 12,
+						Second: 29,
+					}),
+					TargetStatus: 'T',
+				},
+			},
+			{
+				S: "$RATTM,11,25.3,13.7,T,7.0,20.0,T,10.1,20.0,N,THEM,Q,,230020.00,A*23",
+				Expected: &standard.TTM{
+					Address:          nmea.NewAddress("RATTM"),
+					TargetNumber:     11,
+					Distance:         nmea.NewOptional(25.3),
+					Bearing:          nmea.NewOptional(13.7),
+					BearingReference: 'T',
+					Speed:            nmea.NewOptional(7.0),
+					Course:           nmea.NewOptional(20.0),
+					CourseReference:  'T',
+					CPADistance:      nmea.NewOptional(10.1),
+					TCPA:             nmea.NewOptional(20.0),
+					Units:            'N',
+					TargetName:       "THEM",
+					TargetStatus:     'Q',
+					TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+						Hour:   23,
+						Minute: 0,
+						Second: 20,
+					}),
+					TypeOfAcquisition: nmea.NewOptional[byte]('A'),
+				},
+			},
+			{
+				S: "$RATTM,02,1.43,170.5,T,0.16,264.4,T,1.42,36.9,N,,T,*67",
+				Expected: &standard.TTM{
+					Address:          nmea.NewAddress("RATTM"),
+					TargetNumber:     2,
+					Distance:         nmea.NewOptional(1.43),
+					Bearing:          nmea.NewOptional(170.5),
+					BearingReference: 'T',
+					Speed:            nmea.NewOptional(0.16),
+					Course:           nmea.NewOptional(264.4),
+					CourseReference:  'T',
+					CPADistance:      nmea.NewOptional(1.42),
+					TCPA:             nmea.NewOptional(36.9),
+					Units:            'N',
+					TargetStatus:     'T',
+				},
+			},
+		},
+	)
+}
+
+func TestAlertManagement(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$IIACK,001*54",
+				Expected: &standard.ACK{
+					Address:     nmea.NewAddress("IIACK"),
+					AlarmNumber: 1,
+				},
+			},
+			{
+				S: "$VRACN,220516.00,XYZ,512,1,A,C*3A",
+				Expected: &standard.ACN{
+					Address: nmea.NewAddress("VRACN"),
+					TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+						Hour:   22,
+						Minute: 5,
+						Second: 16,
+					}),
+					ManufacturerCode: "XYZ",
+					Identifier:       512,
+					Instance:         nmea.NewOptional(1),
+					Command:          'A',
+					SentenceStatus:   'C',
+				},
+			},
+			{
+				S: "$VRALC,01,01,00,2,,192,1,0,FEC,3,,1*01",
+				Expected: &standard.ALC{
+					Address:        nmea.NewAddress("VRALC"),
+					NumSentences:   1,
+					SentenceNumber: 1,
+					AlertEntries: []standard.AlertEntry{
+						{
+							Identifier: 192,
+							Instance:   nmea.NewOptional(1),
+						},
+						{
+							ManufacturerCode: "FEC",
+							Identifier:       3,
+							RevisionCounter:  1,
+						},
+					},
+				},
+			},
+			{
+				S: "$VRALF,1,1,0,124304.50,A,W,A,,192,1,1,0,LOST TARGET*10",
+				Expected: &standard.ALF{
+					Address:        nmea.NewAddress("VRALF"),
+					NumSentences:   1,
+					SentenceNumber: 1,
+					SequentialID:   nmea.NewOptional(0),
+					TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+						Hour:       12,
+						Minute:     43,
+						Second:     4,
+						Nanosecond: 500000000,
+					}),
+					Category:          nmea.NewOptional[byte]('A'),
+					Priority:          nmea.NewOptional[byte]('W'),
+					State:             nmea.NewOptional[byte]('A'),
+					Identifier:        nmea.NewOptional(192),
+					Instance:          nmea.NewOptional(1),
+					RevisionCounter:   nmea.NewOptional(1),
+					EscalationCounter: nmea.NewOptional(0),
+					Text:              "LOST TARGET",
+				},
+			},
+			{
+				S: "$VRALF,2,2,1,,,,,XYZ,0512,1,2,0,GYRO FAILURE*5F",
+				Expected: &standard.ALF{
+					Address:           nmea.NewAddress("VRALF"),
+					NumSentences:      2,
+					SentenceNumber:    2,
+					SequentialID:      nmea.NewOptional(1),
+					ManufacturerCode:  "XYZ",
+					Identifier:        nmea.NewOptional(512),
+					Instance:          nmea.NewOptional(1),
+					RevisionCounter:   nmea.NewOptional(2),
+					EscalationCounter: nmea.NewOptional(0),
+					Text:              "GYRO FAILURE",
+				},
+			},
+			{
+				S: "$IIALR,161229.00,001,A,V,HIGH WATER*0F",
+				Expected: &standard.ALR{
+					Address: nmea.NewAddress("IIALR"),
+					TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+						Hour:   16,
+						Minute: 12,
+						Second: 29,
+					}),
+					AlarmNumber:      1,
+					Condition:        'A',
+					AcknowledgeState: 'V',
+					Description:      "HIGH WATER",
+				},
+			},
+			{
+				S: "$VRARC,220516.00,,192,1,S*0C",
+				Expected: &standard.ARC{
+					Address: nmea.NewAddress("VRARC"),
+					TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+						Hour:   22,
+						Minute: 5,
+						Second: 16,
+					}),
+					Identifier: 192,
+					Instance:   nmea.NewOptional(1),
+					Command:    'S',
+				},
+			},
+			{
+				S: "$VRHBT,5,A,3*31",
+				Expected: &standard.HBT{
+					Address:        nmea.NewAddress("VRHBT"),
+					RepeatInterval: nmea.NewOptional(5.0),
+					Status:         'A',
+					SequentialID:   3,
+				},
+			},
+		},
+	)
+}
+
+func TestPropulsion(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$IIETL,123456.00,O,03,20,B,1*49",
+				Expected: &standard.ETL{
+					Address: nmea.NewAddress("IIETL"),
+					TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+						Hour:   12,
+						Minute: 34,
+						Second: 56,
+					}),
+					MessageType:          'O',
+					TelegraphPosition:    nmea.NewOptional(standard.TelegraphPositionAheadHalf),
+					SubTelegraphPosition: nmea.NewOptional(standard.SubTelegraphStandBy),
+					OperatingLocation:    nmea.NewOptional[byte]('B'),
+					Number:               nmea.NewOptional(1),
+				},
+			},
+			{
+				S: "$IIPRC,50.0,A,1200,R,-20.0,P,B,1*58",
+				Expected: &standard.PRC{
+					Address:           nmea.NewAddress("IIPRC"),
+					LeverDemand:       nmea.NewOptional(50.0),
+					LeverDemandStatus: 'A',
+					RPMDemand:         nmea.NewOptional(1200.0),
+					RPMMode:           'R',
+					PitchDemand:       nmea.NewOptional(-20.0),
+					PitchMode:         'P',
+					OperatingLocation: nmea.NewOptional[byte]('B'),
+					Number:            nmea.NewOptional(1),
+				},
+			},
+			{
+				S: "$IIRPM,E,1,2418.2,10.5,A*5F",
+				Expected: &standard.RPM{
+					Address: nmea.NewAddress("IIRPM"),
+					Source:  'E',
+					Number:  1,
+					Speed:   nmea.NewOptional(2418.2),
+					Pitch:   nmea.NewOptional(10.5),
+					Status:  'A',
+				},
+			},
+			{
+				S: "$IIRPM,S,2,-80,,V*71",
+				Expected: &standard.RPM{
+					Address: nmea.NewAddress("IIRPM"),
+					Source:  'S',
+					Number:  2,
+					Speed:   nmea.NewOptional(-80.0),
+					Status:  'V',
+				},
+			},
+			{
+				S: "$IIRSA,10.5,A,,V*4D",
+				Expected: &standard.RSA{
+					Address:               nmea.NewAddress("IIRSA"),
+					StarboardRudderAngle:  nmea.NewOptional(10.5),
+					StarboardRudderStatus: 'A',
+					PortRudderStatus:      'V',
+				},
+			},
+			{
+				S: "$IITRC,1,75.0,P,,V,270.0,B,C*44",
+				Expected: &standard.TRC{
+					Address:           nmea.NewAddress("IITRC"),
+					Number:            1,
+					RPMDemand:         nmea.NewOptional(75.0),
+					RPMMode:           'P',
+					PitchMode:         'V',
+					AzimuthDemand:     nmea.NewOptional(270.0),
+					OperatingLocation: nmea.NewOptional[byte]('B'),
+					SentenceStatus:    'C',
+				},
+			},
+			{
+				S: "$IITRD,1,74.5,P,,V,269.5*4B",
+				Expected: &standard.TRD{
+					Address:         nmea.NewAddress("IITRD"),
+					Number:          1,
+					RPMResponse:     nmea.NewOptional(74.5),
+					RPMMode:         'P',
+					PitchMode:       'V',
+					AzimuthResponse: nmea.NewOptional(269.5),
+				},
+			},
+			{
+				S: "$IIXDR,C,19.52,C,ENGINE#0,P,2.3,B,OILPRESS,T,1500,R,ENGINE#0,U,13.8,V,BATTERY*07",
+				Expected: &standard.XDR{
+					Address: nmea.NewAddress("IIXDR"),
+					Transducers: []standard.Transducer{
+						{
+							Type:  standard.TransducerTypeTemperature,
+							Value: nmea.NewOptional(19.52),
+							Units: nmea.NewOptional[byte]('C'),
+							Name:  "ENGINE#0",
+						},
+						{
+							Type:  standard.TransducerTypePressure,
+							Value: nmea.NewOptional(2.3),
+							Units: nmea.NewOptional[byte]('B'),
+							Name:  "OILPRESS",
+						},
+						{
+							Type:  standard.TransducerTypeTachometer,
+							Value: nmea.NewOptional(1500.0),
+							Units: nmea.NewOptional[byte]('R'),
+							Name:  "ENGINE#0",
+						},
+						{
+							Type:  standard.TransducerTypeVoltage,
+							Value: nmea.NewOptional(13.8),
+							Units: nmea.NewOptional[byte]('V'),
+							Name:  "BATTERY",
+						},
+					},
+				},
+			},
+			{
+				S: "$IIXDR,G,,,GEN*45",
+				Expected: &standard.XDR{
+					Address: nmea.NewAddress("IIXDR"),
+					Transducers: []standard.Transducer{
+						{
+							Type: standard.TransducerTypeGeneric,
+							Name: "GEN",
+						},
+					},
+				},
+			},
+			{
+				S: "$YXXDR,X,1.5,Z,VENDOR,G,,,GEN*68",
+				Expected: &standard.XDR{
+					Address: nmea.NewAddress("YXXDR"),
+					Transducers: []standard.Transducer{
+						{
+							Type:  standard.TransducerType('X'),
+							Value: nmea.NewOptional(1.5),
+							Units: nmea.NewOptional[byte]('Z'),
+							Name:  "VENDOR",
+						},
+						{
+							Type: standard.TransducerTypeGeneric,
+							Name: "GEN",
+						},
+					},
+				},
+			},
+		},
+	)
+}
+
+func TestXDRTransducer(t *testing.T) {
+	sentence, err := nmea.NewParser(
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+		nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+	).ParseString("$IIXDR,C,19.52,C,ENGINE#0,P,2.3,B,OILPRESS,T,1500,R,ENGINE#0,U,13.8,V,BATTERY*07")
+	assert.NoError(t, err)
+	xdr, ok := sentence.(*standard.XDR)
+	assert.True(t, ok)
+	transducer, ok := xdr.Transducer(standard.TransducerTypeTachometer, "ENGINE#0")
+	assert.True(t, ok)
+	assert.Equal(t, nmea.NewOptional(1500.0), transducer.Value)
+	assert.Equal(t, "tachometer", transducer.Type.String())
+	_, ok = xdr.Transducer(standard.TransducerTypeTachometer, "ENGINE#1")
+	assert.False(t, ok)
+	assert.Equal(t, "X", standard.TransducerType('X').String())
+}
+
+func TestQuery(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S:        "$CCGPQ,GGA*2B",
+				Expected: standard.NewQuery("CC", "GP", "GGA"),
+			},
+			{
+				S: "$ECIIQ,RPM*34",
+				Expected: &standard.Query{
+					Address:            nmea.NewAddress("ECIIQ"),
+					Requester:          "EC",
+					Target:             "II",
+					RequestedFormatter: "RPM",
+				},
+			},
+			{
+				S: "$PGRMQ,X*2D",
+				Expected: &nmea.Unknown{
+					Address: nmea.NewAddress("PGRMQ"),
+					Fields:  []string{"X"},
+				},
+			},
+		},
+	)
+
+	_, err := standard.ParseQuery("PGRMQ", nmea.NewTokenizer([]byte(",X")))
+	var unexpectedAddressError *nmea.UnexpectedAddressError
+	assert.True(t, errors.As(err, &unexpectedAddressError))
+
+	data, err := nmea.Marshal(standard.NewQuery("CC", "GP", "GGA"))
+	assert.NoError(t, err)
+	assert.Equal(t, "$CCGPQ,GGA*2B\r\n", string(data))
+	assert.Equal(t, "GPQ", standard.NewQuery("CC", "GP", "GGA").Formatter())
+}
+
+func TestGGAMarshal(t *testing.T) {
+	data, err := nmea.Marshal(&standard.GGA{
+		Address: nmea.NewAddress("GPGGA"),
+		TimeOfDay: nmea.NewOptional(nmea.TimeOfDay{
+			Hour:   9,
+			Minute: 27,
+			Second: 25,
+		}),
+		Lat:                              nmea.NewOptional(47.28523316666667),
+		Lon:                              nmea.NewOptional(8.565265),
+		FixQuality:                       4,
+		NumberOfSatellites:               nmea.NewOptional(8),
+		HDOP:                             nmea.NewOptional(1.01),
+		Alt:                              nmea.NewOptional(499.6),
+		HeightOfGeoidAboveWGS84Ellipsoid: nmea.NewOptional(48.0),
+		TimeSinceLastDGPSUpdate:          nmea.NewOptional(1.0),
+		DGPSReferenceStationID:           "0123",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "$GPGGA,092725.00,4717.11399,N,00833.91590,E,4,08,1.01,499.6,M,48,M,1,0123*71\r\n", string(data))
+}
+
 func TestTheNMEA0813InformationSheetIssue4(t *testing.T) {
 	// From https://actisense.com/wp-content/uploads/2020/01/NMEA-0183-Information-sheet-issue-4-1-1.pdf.
 	nmeatest.TestSentenceParserFunc(t,
@@ -1134,6 +1634,26 @@ func TestTheNMEA0813InformationSheetIssue4(t *testing.T) {
 					Temperature: 17.75,
 				},
 			},
+			{
+				S: "$WIMWV,214.8,R,0.1,K,A*28",
+				Expected: &standard.MWV{
+					Address:       nmea.NewAddress("WIMWV"),
+					WindAngle:     nmea.NewOptional(214.8),
+					Reference:     'R',
+					WindSpeed:     nmea.NewOptional(0.1),
+					WindSpeedUnit: 'K',
+					Status:        'A',
+				},
+			},
+			{
+				S: "$WIMWV,,T,,N,V*32",
+				Expected: &standard.MWV{
+					Address:       nmea.NewAddress("WIMWV"),
+					Reference:     'T',
+					WindSpeedUnit: 'N',
+					Status:        'V',
+				},
+			},
 			{
 				S: "$VWVHW,,,,,0.0,N,0.0,K*4D",
 				Expected: &standard.VHW{
@@ -1506,3 +2026,76 @@ func TestNovatel(t *testing.T) {
 		},
 	)
 }
+
+func TestParseInto(t *testing.T) {
+	parser := nmea.NewParser(
+		nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+	)
+
+	var gsv standard.GSV
+	assert.NoError(t, parser.ParseInto(&gsv, []byte("$GPGSV,3,1,10,23,38,230,44,29,71,156,47,07,29,116,41,08,09,081,36*7F")))
+	assert.Equal(t, 4, len(gsv.SatellitesInView))
+	assert.NoError(t, parser.ParseInto(&gsv, []byte("$GPGSV,3,3,10,26,82,187,47,28,43,056,46*77")))
+	assert.Equal(t, standard.GSV{
+		Address: nmea.NewAddress("GPGSV"),
+		NumMsg:  3,
+		MsgNum:  3,
+		NumSV:   10,
+		SatellitesInView: []standard.SatelliteInView{
+			{SVID: 26, Elv: nmea.NewOptional(82), Az: nmea.NewOptional(187), CNO: nmea.NewOptional(47)},
+			{SVID: 28, Elv: nmea.NewOptional(43), Az: nmea.NewOptional(56), CNO: nmea.NewOptional(46)},
+		},
+	}, gsv)
+
+	var unexpectedAddressError *nmea.UnexpectedAddressError
+	assert.True(t, errors.As(parser.ParseInto(&gsv, []byte("$GPGSA,A,3,23,29,07,08,09,18,26,28,,,,,1.94,1.18,1.54*0D")), &unexpectedAddressError))
+	assert.Equal(t, "GPGSA", unexpectedAddressError.Address)
+}
+
+func BenchmarkParse(b *testing.B) {
+	parser := nmea.NewParser(
+		nmea.WithSentenceParserFunc(standard.SentenceParserFunc),
+	)
+	data := []byte("$GPGGA,092725.00,4717.11399,N,00833.91590,E,1,08,1.01,499.6,M,48.0,M,,*5B\r\n")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := parser.Parse(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseInto(b *testing.B) {
+	parser := nmea.NewParser()
+	for _, s := range []struct {
+		name string
+		dst  nmea.SentenceDecoder
+		data string
+	}{
+		{
+			name: "GGA",
+			dst:  &standard.GGA{},
+			data: "$GPGGA,092725.00,4717.11399,N,00833.91590,E,1,08,1.01,499.6,M,48.0,M,,*5B\r\n",
+		},
+		{
+			name: "GSV",
+			dst:  &standard.GSV{},
+			data: "$GPGSV,3,1,10,23,38,230,44,29,71,156,47,07,29,116,41,08,09,081,36*7F\r\n",
+		},
+		{
+			name: "RMC",
+			dst:  &standard.RMC{},
+			data: "$GPRMC,083559.00,A,4717.11437,N,00833.91522,E,0.004,77.52,091202,,,A*57\r\n",
+		},
+	} {
+		b.Run(s.name, func(b *testing.B) {
+			data := []byte(s.data)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := parser.ParseInto(s.dst, data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+// A Talker is a talker ID.
+type Talker string
+
+// Talkers.
+const (
+	TalkerAISBaseStation               Talker = "AB"
+	TalkerAISDependentBaseStation      Talker = "AD"
+	TalkerAutopilotGeneral             Talker = "AG"
+	TalkerAISMobileStation             Talker = "AI"
+	TalkerAISAidToNavigation           Talker = "AN"
+	TalkerAutopilotMagnetic            Talker = "AP"
+	TalkerAISReceivingStation          Talker = "AR"
+	TalkerAISLimitedBaseStation        Talker = "AS"
+	TalkerAISTransmittingStation       Talker = "AT"
+	TalkerAISSimplexRepeater           Talker = "AX"
+	TalkerBeiDouLegacy                 Talker = "BD"
+	TalkerBilgeSystems                 Talker = "BI"
+	TalkerBridgeNavigationalWatchAlarm Talker = "BN"
+	TalkerCentralAlarm                 Talker = "CA"
+	TalkerDSC                          Talker = "CD"
+	TalkerDataReceiver                 Talker = "CR"
+	TalkerSatelliteCommunications      Talker = "CS"
+	TalkerRadioTelephoneMFHF           Talker = "CT"
+	TalkerRadioTelephoneVHF            Talker = "CV"
+	TalkerScanningReceiver             Talker = "CX"
+	TalkerDECCA                        Talker = "DE"
+	TalkerDirectionFinder              Talker = "DF"
+	TalkerVelocityMagnetic             Talker = "DM"
+	TalkerDynamicPosition              Talker = "DP"
+	TalkerDuplexRepeater               Talker = "DU"
+	TalkerECDIS                        Talker = "EC"
+	TalkerEPIRB                        Talker = "EP"
+	TalkerEngineRoomMonitoring         Talker = "ER"
+	TalkerFireDoor                     Talker = "FD"
+	TalkerFireExtinguisher             Talker = "FE"
+	TalkerFireDetection                Talker = "FR"
+	TalkerFireSprinkler                Talker = "FS"
+	TalkerGalileo                      Talker = "GA"
+	TalkerBeiDou                       Talker = "GB"
+	TalkerNavIC                        Talker = "GI"
+	TalkerGLONASS                      Talker = "GL"
+	TalkerGNSS                         Talker = "GN"
+	TalkerGPS                          Talker = "GP"
+	TalkerQZSS                         Talker = "GQ"
+	TalkerHeadingMagneticCompass       Talker = "HC"
+	TalkerHullDoor                     Talker = "HD"
+	TalkerHeadingNorthSeekingGyro      Talker = "HE"
+	TalkerHeadingFluxgate              Talker = "HF"
+	TalkerHeadingNonNorthSeekingGyro   Talker = "HN"
+	TalkerHullStress                   Talker = "HS"
+	TalkerIntegratedInstrumentation    Talker = "II"
+	TalkerIntegratedNavigation         Talker = "IN"
+	TalkerAlarmAndMonitoring           Talker = "JA"
+	TalkerLoranC                       Talker = "LC"
+	TalkerNavigationLights             Talker = "NL"
+	TalkerRadar                        Talker = "RA"
+	TalkerRecordBook                   Talker = "RB"
+	TalkerPropulsionMachinery          Talker = "RC"
+	TalkerRudderAngleIndicator         Talker = "RI"
+	TalkerAISPhysicalShoreStation      Talker = "SA"
+	TalkerDepthSounder                 Talker = "SD"
+	TalkerSteeringGear                 Talker = "SG"
+	TalkerElectronicPositioning        Talker = "SN"
+	TalkerScanningSounder              Talker = "SS"
+	TalkerTurnRateIndicator            Talker = "TI"
+	TalkerMicroprocessorController     Talker = "UP"
+	TalkerVDESASM                      Talker = "VA"
+	TalkerVelocityDoppler              Talker = "VD"
+	TalkerVelocityWaterMagnetic        Talker = "VM"
+	TalkerVoyageDataRecorder           Talker = "VR"
+	TalkerVDESSatellite                Talker = "VS"
+	TalkerVDESTerrestrial              Talker = "VT"
+	TalkerVelocityWaterMechanical      Talker = "VW"
+	TalkerWatertightDoor               Talker = "WD"
+	TalkerWeatherInstruments           Talker = "WI"
+	TalkerWaterLevelDetection          Talker = "WL"
+	TalkerTransducer                   Talker = "YX"
+	TalkerAtomicClock                  Talker = "ZA"
+	TalkerChronometer                  Talker = "ZC"
+	TalkerQuartzClock                  Talker = "ZQ"
+	TalkerRadioUpdateClock             Talker = "ZV"
+)
+
+// talkerNames contains the names of all known talkers.
+var talkerNames = map[Talker]string{
+	TalkerAISBaseStation:               "AIS base station",
+	TalkerAISDependentBaseStation:      "AIS dependent base station",
+	TalkerAutopilotGeneral:             "Autopilot - general",
+	TalkerAISMobileStation:             "AIS mobile station",
+	TalkerAISAidToNavigation:           "AIS aid to navigation",
+	TalkerAutopilotMagnetic:            "Autopilot - magnetic",
+	TalkerAISReceivingStation:          "AIS receiving station",
+	TalkerAISLimitedBaseStation:        "AIS limited base station",
+	TalkerAISTransmittingStation:       "AIS transmitting station",
+	TalkerAISSimplexRepeater:           "AIS simplex repeater",
+	TalkerBeiDouLegacy:                 "BeiDou",
+	TalkerBilgeSystems:                 "Bilge systems",
+	TalkerBridgeNavigationalWatchAlarm: "Bridge navigational watch alarm system",
+	TalkerCentralAlarm:                 "Central alarm management",
+	TalkerDSC:                          "Communications - digital selective calling",
+	TalkerDataReceiver:                 "Communications - data receiver",
+	TalkerSatelliteCommunications:      "Communications - satellite",
+	TalkerRadioTelephoneMFHF:           "Communications - radio-telephone (MF/HF)",
+	TalkerRadioTelephoneVHF:            "Communications - radio-telephone (VHF)",
+	TalkerScanningReceiver:             "Communications - scanning receiver",
+	TalkerDECCA:                        "DECCA navigator",
+	TalkerDirectionFinder:              "Direction finder",
+	TalkerVelocityMagnetic:             "Velocity sensor, speed log, water, magnetic",
+	TalkerDynamicPosition:              "Dynamic position",
+	TalkerDuplexRepeater:               "Duplex repeater station",
+	TalkerECDIS:                        "Electronic chart display and information system",
+	TalkerEPIRB:                        "Emergency position indicating radio beacon",
+	TalkerEngineRoomMonitoring:         "Engine room monitoring systems",
+	TalkerFireDoor:                     "Fire door controller/monitoring point",
+	TalkerFireExtinguisher:             "Fire extinguisher system",
+	TalkerFireDetection:                "Fire detection point",
+	TalkerFireSprinkler:                "Fire sprinkler system",
+	TalkerGalileo:                      "Galileo",
+	TalkerBeiDou:                       "BeiDou",
+	TalkerNavIC:                        "NavIC",
+	TalkerGLONASS:                      "GLONASS",
+	TalkerGNSS:                         "Any combination of GNSS",
+	TalkerGPS:                          "GPS, SBAS, QZSS",
+	TalkerQZSS:                         "QZSS",
+	TalkerHeadingMagneticCompass:       "Heading - magnetic compass",
+	TalkerHullDoor:                     "Hull door controller/monitoring panel",
+	TalkerHeadingNorthSeekingGyro:      "Heading - north seeking gyro",
+	TalkerHeadingFluxgate:              "Heading - fluxgate",
+	TalkerHeadingNonNorthSeekingGyro:   "Heading - non north seeking gyro",
+	TalkerHullStress:                   "Hull stress monitoring",
+	TalkerIntegratedInstrumentation:    "Integrated instrumentation",
+	TalkerIntegratedNavigation:         "Integrated navigation",
+	TalkerAlarmAndMonitoring:           "Alarm and monitoring system",
+	TalkerLoranC:                       "Loran C",
+	TalkerNavigationLights:             "Navigation light controller",
+	TalkerRadar:                        "Radar and/or radar plotting",
+	TalkerRecordBook:                   "Record book",
+	TalkerPropulsionMachinery:          "Propulsion machinery including remote control",
+	TalkerRudderAngleIndicator:         "Rudder angle indicator",
+	TalkerAISPhysicalShoreStation:      "AIS physical shore station",
+	TalkerDepthSounder:                 "Depth sounder",
+	TalkerSteeringGear:                 "Steering gear/steering engine",
+	TalkerElectronicPositioning:        "Electronic positioning system, other/general",
+	TalkerScanningSounder:              "Scanning sounder",
+	TalkerTurnRateIndicator:            "Turn rate indicator",
+	TalkerMicroprocessorController:     "Microprocessor controller",
+	TalkerVDESASM:                      "VHF data exchange system, application specific messages",
+	TalkerVelocityDoppler:              "Velocity sensor, Doppler, other/general",
+	TalkerVelocityWaterMagnetic:        "Velocity sensor, speed log, water, magnetic",
+	TalkerVoyageDataRecorder:           "Voyage data recorder",
+	TalkerVDESSatellite:                "VHF data exchange system, satellite",
+	TalkerVDESTerrestrial:              "VHF data exchange system, terrestrial",
+	TalkerVelocityWaterMechanical:      "Velocity sensor, speed log, water, mechanical",
+	TalkerWatertightDoor:               "Watertight door controller/monitoring panel",
+	TalkerWeatherInstruments:           "Weather instruments",
+	TalkerWaterLevelDetection:          "Water level detection systems",
+	TalkerTransducer:                   "Transducer",
+	TalkerAtomicClock:                  "Timekeeper - atomic clock",
+	TalkerChronometer:                  "Timekeeper - chronometer",
+	TalkerQuartzClock:                  "Timekeeper - quartz",
+	TalkerRadioUpdateClock:             "Timekeeper - radio update",
+}
+
+// talkerConstellations maps GNSS talkers to their constellations.
+var talkerConstellations = map[Talker]Constellation{
+	TalkerBeiDou:       ConstellationBeiDou,
+	TalkerBeiDouLegacy: ConstellationBeiDou,
+	TalkerGalileo:      ConstellationGalileo,
+	TalkerGLONASS:      ConstellationGLONASS,
+	TalkerGPS:          ConstellationGPS,
+	TalkerNavIC:        ConstellationNavIC,
+	TalkerQZSS:         ConstellationQZSS,
+}
+
+// TalkerOf returns the talker of address.
+func TalkerOf(address nmea.Address) Talker {
+	return Talker(address.Talker())
+}
+
+// Constellation returns t's GNSS constellation, or ConstellationUnknown if t
+// is not a single-constellation GNSS talker.
+func (t Talker) Constellation() Constellation {
+	return talkerConstellations[t]
+}
+
+// Known returns whether t is a known talker. User-configured talkers U0 to U9
+// are known.
+func (t Talker) Known() bool {
+	if _, ok := talkerNames[t]; ok {
+		return true
+	}
+	return len(t) == 2 && t[0] == 'U' && '0' <= t[1] && t[1] <= '9'
+}
+
+// Name returns t's human-readable name, or the empty string if t is not
+// known.
+func (t Talker) Name() string {
+	if name, ok := talkerNames[t]; ok {
+		return name
+	}
+	if t.Known() {
+		return "User configured"
+	}
+	return ""
+}
+
+func (t Talker) String() string {
+	return string(t)
+}
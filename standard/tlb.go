@@ -0,0 +1,29 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type TargetLabel struct {
+	TargetNumber int
+	Label        string
+}
+
+type TLB struct {
+	nmea.Address
+	TargetLabels []TargetLabel
+}
+
+func ParseTLB(addr string, tok *nmea.Tokenizer) (*TLB, error) {
+	var tlb TLB
+	tlb.Address = nmea.NewAddress(addr)
+	for !tok.AtEndOfData() {
+		var targetLabel TargetLabel
+		targetLabel.TargetNumber = tok.CommaUnsignedInt()
+		targetLabel.Label = tok.CommaString()
+		if tok.Err() != nil {
+			break
+		}
+		tlb.TargetLabels = append(tlb.TargetLabels, targetLabel)
+	}
+	tok.EndOfData()
+	return &tlb, tok.Err()
+}
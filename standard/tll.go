@@ -0,0 +1,28 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type TLL struct {
+	nmea.Address
+	TargetNumber    int
+	Lat             float64
+	Lon             float64
+	TargetName      string
+	TimeOfDay       nmea.Optional[nmea.TimeOfDay]
+	TargetStatus    byte
+	ReferenceTarget nmea.Optional[byte]
+}
+
+func ParseTLL(addr string, tok *nmea.Tokenizer) (*TLL, error) {
+	var tll TLL
+	tll.Address = nmea.NewAddress(addr)
+	tll.TargetNumber = tok.CommaUnsignedInt()
+	tll.Lat = tok.CommaLatDegMinCommaHemi()
+	tll.Lon = tok.CommaLonDegMinCommaHemi()
+	tll.TargetName = tok.CommaString()
+	tll.TimeOfDay = tok.CommaOptionalTimeOfDay()
+	tll.TargetStatus = tok.CommaOneByteOf("LQT")
+	tll.ReferenceTarget = tok.CommaOptionalLiteralByte('R')
+	tok.EndOfData()
+	return &tll, tok.Err()
+}
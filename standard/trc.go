@@ -0,0 +1,30 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type TRC struct {
+	nmea.Address
+	Number            int
+	RPMDemand         nmea.Optional[float64]
+	RPMMode           byte
+	PitchDemand       nmea.Optional[float64]
+	PitchMode         byte
+	AzimuthDemand     nmea.Optional[float64]
+	OperatingLocation nmea.Optional[byte]
+	SentenceStatus    byte
+}
+
+func ParseTRC(addr string, tok *nmea.Tokenizer) (*TRC, error) {
+	var trc TRC
+	trc.Address = nmea.NewAddress(addr)
+	trc.Number = tok.CommaUnsignedInt()
+	trc.RPMDemand = tok.CommaOptionalFloat()
+	trc.RPMMode = tok.CommaOneByteOf("PRV")
+	trc.PitchDemand = tok.CommaOptionalFloat()
+	trc.PitchMode = tok.CommaOneByteOf("DPV")
+	trc.AzimuthDemand = tok.CommaOptionalUnsignedFloat()
+	trc.OperatingLocation = tok.CommaOptionalOneByteOf("BCEPSW")
+	trc.SentenceStatus = tok.CommaOneByteOf("CR")
+	tok.EndOfData()
+	return &trc, tok.Err()
+}
@@ -0,0 +1,26 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type TRD struct {
+	nmea.Address
+	Number          int
+	RPMResponse     nmea.Optional[float64]
+	RPMMode         byte
+	PitchResponse   nmea.Optional[float64]
+	PitchMode       byte
+	AzimuthResponse nmea.Optional[float64]
+}
+
+func ParseTRD(addr string, tok *nmea.Tokenizer) (*TRD, error) {
+	var trd TRD
+	trd.Address = nmea.NewAddress(addr)
+	trd.Number = tok.CommaUnsignedInt()
+	trd.RPMResponse = tok.CommaOptionalFloat()
+	trd.RPMMode = tok.CommaOneByteOf("PRV")
+	trd.PitchResponse = tok.CommaOptionalFloat()
+	trd.PitchMode = tok.CommaOneByteOf("DPV")
+	trd.AzimuthResponse = tok.CommaOptionalUnsignedFloat()
+	tok.EndOfData()
+	return &trd, tok.Err()
+}
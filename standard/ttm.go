@@ -0,0 +1,46 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+type TTM struct {
+	nmea.Address
+	TargetNumber      int
+	Distance          nmea.Optional[float64]
+	Bearing           nmea.Optional[float64]
+	BearingReference  byte
+	Speed             nmea.Optional[float64]
+	Course            nmea.Optional[float64]
+	CourseReference   byte
+	CPADistance       nmea.Optional[float64]
+	TCPA              nmea.Optional[float64]
+	Units             byte
+	TargetName        string
+	TargetStatus      byte
+	ReferenceTarget   nmea.Optional[byte]
+	TimeOfDay         nmea.Optional[nmea.TimeOfDay]
+	TypeOfAcquisition nmea.Optional[byte]
+}
+
+func ParseTTM(addr string, tok *nmea.Tokenizer) (*TTM, error) {
+	var ttm TTM
+	ttm.Address = nmea.NewAddress(addr)
+	ttm.TargetNumber = tok.CommaUnsignedInt()
+	ttm.Distance = tok.CommaOptionalUnsignedFloat()
+	ttm.Bearing = tok.CommaOptionalUnsignedFloat()
+	ttm.BearingReference = tok.CommaOneByteOf("RT")
+	ttm.Speed = tok.CommaOptionalUnsignedFloat()
+	ttm.Course = tok.CommaOptionalUnsignedFloat()
+	ttm.CourseReference = tok.CommaOneByteOf("RT")
+	ttm.CPADistance = tok.CommaOptionalUnsignedFloat()
+	ttm.TCPA = tok.CommaOptionalFloat()
+	ttm.Units = tok.CommaOneByteOf("KNS")
+	ttm.TargetName = tok.CommaString()
+	ttm.TargetStatus = tok.CommaOneByteOf("LQT")
+	ttm.ReferenceTarget = tok.CommaOptionalLiteralByte('R')
+	if !tok.AtEndOfData() {
+		ttm.TimeOfDay = tok.CommaOptionalTimeOfDay()
+		ttm.TypeOfAcquisition = tok.CommaOptionalOneByteOf("AMR")
+	}
+	tok.EndOfData()
+	return &ttm, tok.Err()
+}
@@ -13,12 +13,26 @@ type VTG struct {
 
 func ParseVTG(addr string, tok *nmea.Tokenizer) (*VTG, error) {
 	var vtg VTG
-	vtg.Address = nmea.NewAddress(addr)
+	err := vtg.decode(addr, tok)
+	return &vtg, err
+}
+
+func (vtg *VTG) Decode(addr string, tok *nmea.Tokenizer) error {
+	if err := checkFormatter(addr, "VTG"); err != nil {
+		return err
+	}
+	return vtg.decode(addr, tok)
+}
+
+func (vtg *VTG) decode(addr string, tok *nmea.Tokenizer) error {
+	*vtg = VTG{
+		Address: nmea.NewAddress(addr),
+	}
 	vtg.CourseOverGroundTrue = tok.CommaOptionalFloatCommaUnit('T')
 	vtg.CourseOverGroundMagnetic = tok.CommaOptionalFloatCommaUnit('M')
 	vtg.SpeedOverGroundKN = tok.CommaOptionalFloatCommaUnit('N')
 	vtg.SpeedOverGroundKPH = tok.CommaOptionalFloatCommaUnit('K')
 	vtg.ModeIndicator = tok.CommaOneByteOf("ADEFNR")
 	tok.EndOfData()
-	return &vtg, tok.Err()
+	return tok.Err()
 }
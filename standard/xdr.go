@@ -0,0 +1,103 @@
+package standard
+
+import "github.com/twpayne/go-nmea"
+
+// A TransducerType is the type of an XDR transducer. XDR is widely extended
+// by vendors, so transducer types and units are not restricted to the known
+// values.
+type TransducerType byte
+
+const (
+	TransducerTypeAngularDisplacement TransducerType = 'A'
+	TransducerTypeTemperature         TransducerType = 'C'
+	TransducerTypeLinearDisplacement  TransducerType = 'D'
+	TransducerTypeFrequency           TransducerType = 'F'
+	TransducerTypeGeneric             TransducerType = 'G'
+	TransducerTypeHumidity            TransducerType = 'H'
+	TransducerTypeCurrent             TransducerType = 'I'
+	TransducerTypeSalinity            TransducerType = 'L'
+	TransducerTypeForce               TransducerType = 'N'
+	TransducerTypePressure            TransducerType = 'P'
+	TransducerTypeFlowRate            TransducerType = 'R'
+	TransducerTypeSwitch              TransducerType = 'S'
+	TransducerTypeTachometer          TransducerType = 'T'
+	TransducerTypeVoltage             TransducerType = 'U'
+	TransducerTypeVolume              TransducerType = 'V'
+)
+
+func (t TransducerType) String() string {
+	switch t {
+	case TransducerTypeAngularDisplacement:
+		return "angular displacement"
+	case TransducerTypeTemperature:
+		return "temperature"
+	case TransducerTypeLinearDisplacement:
+		return "linear displacement"
+	case TransducerTypeFrequency:
+		return "frequency"
+	case TransducerTypeGeneric:
+		return "generic"
+	case TransducerTypeHumidity:
+		return "humidity"
+	case TransducerTypeCurrent:
+		return "current"
+	case TransducerTypeSalinity:
+		return "salinity"
+	case TransducerTypeForce:
+		return "force"
+	case TransducerTypePressure:
+		return "pressure"
+	case TransducerTypeFlowRate:
+		return "flow rate"
+	case TransducerTypeSwitch:
+		return "switch"
+	case TransducerTypeTachometer:
+		return "tachometer"
+	case TransducerTypeVoltage:
+		return "voltage"
+	case TransducerTypeVolume:
+		return "volume"
+	default:
+		return string(rune(t))
+	}
+}
+
+type Transducer struct {
+	Type  TransducerType
+	Value nmea.Optional[float64]
+	Units nmea.Optional[byte]
+	Name  string
+}
+
+type XDR struct {
+	nmea.Address
+	Transducers []Transducer
+}
+
+func ParseXDR(addr string, tok *nmea.Tokenizer) (*XDR, error) {
+	var xdr XDR
+	xdr.Address = nmea.NewAddress(addr)
+	for !tok.AtEndOfData() {
+		var transducer Transducer
+		transducer.Type = TransducerType(tok.CommaAnyByte())
+		transducer.Value = tok.CommaOptionalFloat()
+		transducer.Units = tok.CommaOptionalAnyByte()
+		transducer.Name = tok.CommaString()
+		if tok.Err() != nil {
+			break
+		}
+		xdr.Transducers = append(xdr.Transducers, transducer)
+	}
+	tok.EndOfData()
+	return &xdr, tok.Err()
+}
+
+// Transducer returns the first transducer with the given type and name.
+func (xdr *XDR) Transducer(transducerType TransducerType, name string) (Transducer, bool) {
+	for _, transducer := range xdr.Transducers {
+		if transducer.Type == transducerType && transducer.Name == name {
+			return transducer, true
+		}
+	}
+	return Transducer{}, false
+}
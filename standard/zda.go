@@ -15,14 +15,28 @@ type ZDA struct {
 
 func ParseZDA(addr string, tok *nmea.Tokenizer) (*ZDA, error) {
 	var zda ZDA
-	zda.Address = nmea.NewAddress(addr)
+	err := zda.decode(addr, tok)
+	return &zda, err
+}
+
+func (zda *ZDA) Decode(addr string, tok *nmea.Tokenizer) error {
+	if err := checkFormatter(addr, "ZDA"); err != nil {
+		return err
+	}
+	return zda.decode(addr, tok)
+}
+
+func (zda *ZDA) decode(addr string, tok *nmea.Tokenizer) error {
+	*zda = ZDA{
+		Address: nmea.NewAddress(addr),
+	}
 	timeOfDay := tok.CommaTimeOfDay()
 	day := tok.CommaUnsignedInt()
 	month := time.Month(tok.CommaUnsignedInt())
 	year := tok.CommaUnsignedInt()
-	zda.Time = time.Date(year, month, day, timeOfDay.Hour, timeOfDay.Minute, timeOfDay.Second, timeOfDay.Nanosecond, time.UTC)
+	zda.Time = nmea.Date{Year: year, Month: month, Day: day}.At(timeOfDay)
 	zda.LocalTimeZoneHours = tok.CommaOptionalInt()
 	zda.LocalTimeZoneMinutes = tok.CommaOptionalInt()
 	tok.EndOfData()
-	return &zda, tok.Err()
+	return tok.Err()
 }
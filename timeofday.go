@@ -1,7 +1,5 @@
 package nmea
 
-// FIXME what happens to second on GPS leap second?
-
 import (
 	"fmt"
 	"time"
@@ -25,6 +23,12 @@ func (t TimeOfDay) SinceMidnight() time.Duration {
 		time.Duration(t.Nanosecond)*time.Nanosecond
 }
 
+// LeapSecond returns true if t is during an inserted leap second, which
+// occurs as second 60 of the last minute of a UTC day.
+func (t TimeOfDay) LeapSecond() bool {
+	return t.Hour == 23 && t.Minute == 59 && t.Second == 60
+}
+
 func (t TimeOfDay) Valid() bool {
 	if t.Hour < 0 || 23 < t.Hour {
 		return false
@@ -32,11 +36,10 @@ func (t TimeOfDay) Valid() bool {
 	if t.Minute < 0 || 59 < t.Minute {
 		return false
 	}
-	// FIXME what happens to second on GPS leap second?
-	if t.Second < 0 || 60 < t.Second {
+	if t.Second < 0 || (59 < t.Second && !t.LeapSecond()) {
 		return false
 	}
-	if t.Nanosecond < 0 || 99999999 < t.Nanosecond {
+	if t.Nanosecond < 0 || 999999999 < t.Nanosecond {
 		return false
 	}
 	return true
@@ -66,9 +69,11 @@ func (t *Tokenizer) OptionalTimeOfDay() Optional[TimeOfDay] {
 }
 
 func (t *Tokenizer) TimeOfDay() TimeOfDay {
+	prev := t.expect(FieldKindTimeOfDay)
 	hour := t.DecimalDigits(2)
 	min := t.DecimalDigits(2)
 	sec, nsec := secondPointNanosecond(t)
+	t.kind = prev
 	return TimeOfDay{
 		Hour:       hour,
 		Minute:     min,
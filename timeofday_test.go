@@ -107,6 +107,16 @@ func TestTimeOfDay(t *testing.T) {
 			expectedString:        "23:59:59.999999999",
 			expectedSinceMidnight: 24*time.Hour - time.Nanosecond,
 		},
+		{
+			timeOfDay: nmea.TimeOfDay{
+				Hour:       23,
+				Minute:     59,
+				Second:     60,
+				Nanosecond: 500000000,
+			},
+			expectedString:        "23:59:60.500000000",
+			expectedSinceMidnight: 24*time.Hour + 500*time.Millisecond,
+		},
 		{
 			timeOfDay: nmea.TimeOfDay{
 				Hour: -1,
@@ -139,6 +149,14 @@ func TestTimeOfDay(t *testing.T) {
 		},
 		{
 			timeOfDay: nmea.TimeOfDay{
+				Second: 60,
+			},
+			expectedInvalid: true,
+		},
+		{
+			timeOfDay: nmea.TimeOfDay{
+				Hour:   23,
+				Minute: 59,
 				Second: 61,
 			},
 			expectedInvalid: true,
@@ -161,9 +179,31 @@ func TestTimeOfDay(t *testing.T) {
 			if tc.expectedInvalid {
 				assert.False(t, actualValid)
 			} else {
+				assert.True(t, actualValid)
 				assert.Equal(t, tc.expectedString, tc.timeOfDay.String())
 				assert.Equal(t, tc.expectedSinceMidnight, tc.timeOfDay.SinceMidnight())
 			}
 		})
 	}
 }
+
+func TestDateAt(t *testing.T) {
+	date := nmea.Date{Year: 2016, Month: time.December, Day: 31}
+	for _, tc := range []struct {
+		timeOfDay nmea.TimeOfDay
+		expected  time.Time
+	}{
+		{
+			timeOfDay: nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 59, Nanosecond: 500000000},
+			expected:  time.Date(2016, time.December, 31, 23, 59, 59, 500000000, time.UTC),
+		},
+		{
+			timeOfDay: nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 60, Nanosecond: 500000000},
+			expected:  time.Date(2016, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+		},
+	} {
+		t.Run(tc.timeOfDay.String(), func(t *testing.T) {
+			assert.Equal(t, tc.expected, date.At(tc.timeOfDay))
+		})
+	}
+}
@@ -0,0 +1,229 @@
+// Package timescale converts between the GPS, TAI, and UTC time scales.
+//
+// GPS time and TAI are continuous and differ by a constant 19 seconds. UTC
+// is kept within a second of the Earth's rotation by inserting leap seconds,
+// which appear as second 60 of the last minute of a UTC day.
+//
+// time.Time cannot represent leap seconds, so continuous times are
+// represented as time.Times whose fields are read on the TAI scale, and UTC
+// times are represented as an nmea.Date and an nmea.TimeOfDay, which can
+// hold second 60.
+package timescale
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/twpayne/go-nmea"
+)
+
+const (
+	// TAIMinusGPS is the constant difference between TAI and GPS time.
+	TAIMinusGPS = 19 * time.Second
+
+	// Week is the length of a GPS week.
+	Week = 7 * 24 * time.Hour
+)
+
+// GPSEpoch is the start of GPS week zero, read on the GPS time scale.
+var GPSEpoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+var (
+	ErrInvalidTimeOfDay = errors.New("invalid time of day")
+	ErrNotLeapSecond    = errors.New("not a leap second")
+)
+
+// A LeapSecond is a change in the difference between TAI and UTC.
+type LeapSecond struct {
+	Time        time.Time // The UTC time from which TAIMinusUTC applies.
+	TAIMinusUTC time.Duration
+}
+
+// A Table is a list of LeapSeconds in increasing order of time. Times
+// before the first LeapSecond use its difference.
+type Table []LeapSecond
+
+// DefaultTable contains all leap seconds announced by the IERS up to and
+// including the leap second at the end of 2016.
+var DefaultTable = Table{
+	{Time: time.Date(1972, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 10 * time.Second},
+	{Time: time.Date(1972, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 11 * time.Second},
+	{Time: time.Date(1973, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 12 * time.Second},
+	{Time: time.Date(1974, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 13 * time.Second},
+	{Time: time.Date(1975, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 14 * time.Second},
+	{Time: time.Date(1976, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 15 * time.Second},
+	{Time: time.Date(1977, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 16 * time.Second},
+	{Time: time.Date(1978, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 17 * time.Second},
+	{Time: time.Date(1979, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 18 * time.Second},
+	{Time: time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 19 * time.Second},
+	{Time: time.Date(1981, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 20 * time.Second},
+	{Time: time.Date(1982, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 21 * time.Second},
+	{Time: time.Date(1983, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 22 * time.Second},
+	{Time: time.Date(1985, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 23 * time.Second},
+	{Time: time.Date(1988, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 24 * time.Second},
+	{Time: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 25 * time.Second},
+	{Time: time.Date(1991, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 26 * time.Second},
+	{Time: time.Date(1992, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 27 * time.Second},
+	{Time: time.Date(1993, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 28 * time.Second},
+	{Time: time.Date(1994, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 29 * time.Second},
+	{Time: time.Date(1996, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 30 * time.Second},
+	{Time: time.Date(1997, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 31 * time.Second},
+	{Time: time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 32 * time.Second},
+	{Time: time.Date(2006, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 33 * time.Second},
+	{Time: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 34 * time.Second},
+	{Time: time.Date(2012, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 35 * time.Second},
+	{Time: time.Date(2015, time.July, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 36 * time.Second},
+	{Time: time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC), TAIMinusUTC: 37 * time.Second},
+}
+
+// TAIMinusUTC returns the difference between TAI and UTC at utc.
+func (t Table) TAIMinusUTC(utc time.Time) time.Duration {
+	return t[t.index(utc)].TAIMinusUTC
+}
+
+// GPSMinusUTC returns the difference between GPS time and UTC at utc. This
+// is the leap second count reported by receivers.
+func (t Table) GPSMinusUTC(utc time.Time) time.Duration {
+	return t.TAIMinusUTC(utc) - TAIMinusGPS
+}
+
+// TAI returns the TAI time of the UTC time at timeOfDay on date. Second 60
+// is accepted only at the end of a day that ends with a leap second, or at
+// the end of any day after the last leap second in t, since t may not
+// contain recently announced leap seconds.
+func (t Table) TAI(date nmea.Date, timeOfDay nmea.TimeOfDay) (time.Time, error) {
+	if !timeOfDay.Valid() {
+		return time.Time{}, ErrInvalidTimeOfDay
+	}
+	utc := date.At(timeOfDay)
+	if !timeOfDay.LeapSecond() {
+		return utc.Add(t.TAIMinusUTC(utc)), nil
+	}
+	// utc is the last nanosecond of 23:59:59, so the leap second starts one
+	// nanosecond later.
+	start := utc.Add(time.Nanosecond)
+	i := t.index(start)
+	switch {
+	case t[i].Time.Equal(start) && i > 0:
+		return start.Add(t[i-1].TAIMinusUTC).Add(time.Duration(timeOfDay.Nanosecond)), nil
+	case i == len(t)-1 && start.After(t[i].Time):
+		return start.Add(t[i].TAIMinusUTC).Add(time.Duration(timeOfDay.Nanosecond)), nil
+	default:
+		return time.Time{}, ErrNotLeapSecond
+	}
+}
+
+// UTC returns the UTC date and time of day at tai. Times during a leap
+// second have second 60.
+func (t Table) UTC(tai time.Time) (nmea.Date, nmea.TimeOfDay) {
+	i := sort.Search(len(t), func(i int) bool {
+		return tai.Before(t[i].Time.Add(t[i].TAIMinusUTC))
+	}) - 1
+	if i < 0 {
+		i = 0
+	}
+	utc := tai.Add(-t[i].TAIMinusUTC)
+	leapSecond := false
+	if i+1 < len(t) && !utc.Before(t[i+1].Time) {
+		utc = utc.Add(-time.Second)
+		leapSecond = true
+	}
+	year, month, day := utc.Date()
+	date := nmea.Date{
+		Year:  year,
+		Month: month,
+		Day:   day,
+	}
+	timeOfDay := nmea.TimeOfDay{
+		Hour:       utc.Hour(),
+		Minute:     utc.Minute(),
+		Second:     utc.Second(),
+		Nanosecond: utc.Nanosecond(),
+	}
+	if leapSecond {
+		timeOfDay.Second = 60
+	}
+	return date, timeOfDay
+}
+
+// GPSToUTC returns the UTC date and time of day at GPS week and time of
+// week tow.
+func (t Table) GPSToUTC(week int, tow time.Duration) (nmea.Date, nmea.TimeOfDay) {
+	return t.UTC(GPSToTAI(week, tow))
+}
+
+// UTCToGPS returns the GPS week and time of week of the UTC time at
+// timeOfDay on date.
+func (t Table) UTCToGPS(date nmea.Date, timeOfDay nmea.TimeOfDay) (int, time.Duration, error) {
+	tai, err := t.TAI(date, timeOfDay)
+	if err != nil {
+		return 0, 0, err
+	}
+	week, tow := TAIToGPS(tai)
+	return week, tow, nil
+}
+
+// index returns the index of the LeapSecond in effect at utc.
+func (t Table) index(utc time.Time) int {
+	i := sort.Search(len(t), func(i int) bool {
+		return utc.Before(t[i].Time)
+	}) - 1
+	if i < 0 {
+		return 0
+	}
+	return i
+}
+
+// GPSToTAI returns the TAI time at GPS week and time of week tow. week is
+// the full week number, not the ten-bit week number broadcast by
+// satellites.
+func GPSToTAI(week int, tow time.Duration) time.Time {
+	return GPSEpoch.Add(time.Duration(week)*Week + tow + TAIMinusGPS)
+}
+
+// TAIToGPS returns the GPS week and time of week at tai.
+func TAIToGPS(tai time.Time) (int, time.Duration) {
+	d := tai.Sub(GPSEpoch) - TAIMinusGPS
+	week, tow := d/Week, d%Week
+	if tow < 0 {
+		week--
+		tow += Week
+	}
+	return int(week), tow
+}
+
+// TAIMinusUTC returns the difference between TAI and UTC at utc using
+// DefaultTable.
+func TAIMinusUTC(utc time.Time) time.Duration {
+	return DefaultTable.TAIMinusUTC(utc)
+}
+
+// GPSMinusUTC returns the difference between GPS time and UTC at utc using
+// DefaultTable.
+func GPSMinusUTC(utc time.Time) time.Duration {
+	return DefaultTable.GPSMinusUTC(utc)
+}
+
+// TAI returns the TAI time of the UTC time at timeOfDay on date using
+// DefaultTable.
+func TAI(date nmea.Date, timeOfDay nmea.TimeOfDay) (time.Time, error) {
+	return DefaultTable.TAI(date, timeOfDay)
+}
+
+// UTC returns the UTC date and time of day at tai using DefaultTable.
+func UTC(tai time.Time) (nmea.Date, nmea.TimeOfDay) {
+	return DefaultTable.UTC(tai)
+}
+
+// GPSToUTC returns the UTC date and time of day at GPS week and time of
+// week tow using DefaultTable.
+func GPSToUTC(week int, tow time.Duration) (nmea.Date, nmea.TimeOfDay) {
+	return DefaultTable.GPSToUTC(week, tow)
+}
+
+// UTCToGPS returns the GPS week and time of week of the UTC time at
+// timeOfDay on date using DefaultTable.
+func UTCToGPS(date nmea.Date, timeOfDay nmea.TimeOfDay) (int, time.Duration, error) {
+	return DefaultTable.UTCToGPS(date, timeOfDay)
+}
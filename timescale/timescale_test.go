@@ -0,0 +1,186 @@
+package timescale_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/timescale"
+)
+
+func TestTAIMinusUTC(t *testing.T) {
+	for _, tc := range []struct {
+		utc                 time.Time
+		expectedTAIMinusUTC time.Duration
+		expectedGPSMinusUTC time.Duration
+	}{
+		{
+			utc:                 time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expectedTAIMinusUTC: 10 * time.Second,
+			expectedGPSMinusUTC: -9 * time.Second,
+		},
+		{
+			utc:                 time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC),
+			expectedTAIMinusUTC: 19 * time.Second,
+			expectedGPSMinusUTC: 0,
+		},
+		{
+			utc:                 time.Date(2005, time.March, 16, 9, 38, 2, 0, time.UTC),
+			expectedTAIMinusUTC: 32 * time.Second,
+			expectedGPSMinusUTC: 13 * time.Second,
+		},
+		{
+			utc:                 time.Date(2016, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+			expectedTAIMinusUTC: 36 * time.Second,
+			expectedGPSMinusUTC: 17 * time.Second,
+		},
+		{
+			utc:                 time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expectedTAIMinusUTC: 37 * time.Second,
+			expectedGPSMinusUTC: 18 * time.Second,
+		},
+	} {
+		t.Run(tc.utc.Format(time.RFC3339Nano), func(t *testing.T) {
+			assert.Equal(t, tc.expectedTAIMinusUTC, timescale.TAIMinusUTC(tc.utc))
+			assert.Equal(t, tc.expectedGPSMinusUTC, timescale.GPSMinusUTC(tc.utc))
+		})
+	}
+}
+
+func TestTAIAndUTC(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		date      nmea.Date
+		timeOfDay nmea.TimeOfDay
+		tai       time.Time
+	}{
+		{
+			name:      "before_leap_second",
+			date:      nmea.Date{Year: 2016, Month: time.December, Day: 31},
+			timeOfDay: nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 59, Nanosecond: 500000000},
+			tai:       time.Date(2017, time.January, 1, 0, 0, 35, 500000000, time.UTC),
+		},
+		{
+			name:      "leap_second",
+			date:      nmea.Date{Year: 2016, Month: time.December, Day: 31},
+			timeOfDay: nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 60},
+			tai:       time.Date(2017, time.January, 1, 0, 0, 36, 0, time.UTC),
+		},
+		{
+			name:      "leap_second_fraction",
+			date:      nmea.Date{Year: 2016, Month: time.December, Day: 31},
+			timeOfDay: nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 60, Nanosecond: 500000000},
+			tai:       time.Date(2017, time.January, 1, 0, 0, 36, 500000000, time.UTC),
+		},
+		{
+			name: "after_leap_second",
+			date: nmea.Date{Year: 2017, Month: time.January, Day: 1},
+			tai:  time.Date(2017, time.January, 1, 0, 0, 37, 0, time.UTC),
+		},
+		{
+			name:      "june_leap_second",
+			date:      nmea.Date{Year: 2015, Month: time.June, Day: 30},
+			timeOfDay: nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 60, Nanosecond: 250000000},
+			tai:       time.Date(2015, time.July, 1, 0, 0, 35, 250000000, time.UTC),
+		},
+		{
+			name:      "before_table",
+			date:      nmea.Date{Year: 1970, Month: time.January, Day: 1},
+			timeOfDay: nmea.TimeOfDay{Hour: 12},
+			tai:       time.Date(1970, time.January, 1, 12, 0, 10, 0, time.UTC),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actualTAI, err := timescale.TAI(tc.date, tc.timeOfDay)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.tai, actualTAI)
+			actualDate, actualTimeOfDay := timescale.UTC(tc.tai)
+			assert.Equal(t, tc.date, actualDate)
+			assert.Equal(t, tc.timeOfDay, actualTimeOfDay)
+		})
+	}
+}
+
+func TestTAIErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		date        nmea.Date
+		timeOfDay   nmea.TimeOfDay
+		expectedErr error
+	}{
+		{
+			name:        "not_leap_second",
+			date:        nmea.Date{Year: 2016, Month: time.June, Day: 30},
+			timeOfDay:   nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 60},
+			expectedErr: timescale.ErrNotLeapSecond,
+		},
+		{
+			name:        "not_end_of_day",
+			date:        nmea.Date{Year: 2016, Month: time.December, Day: 31},
+			timeOfDay:   nmea.TimeOfDay{Hour: 12, Minute: 0, Second: 60},
+			expectedErr: timescale.ErrInvalidTimeOfDay,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := timescale.TAI(tc.date, tc.timeOfDay)
+			assert.IsError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestTableTAIUnannouncedLeapSecond(t *testing.T) {
+	table := timescale.DefaultTable[:len(timescale.DefaultTable)-1]
+	actual, err := table.TAI(
+		nmea.Date{Year: 2016, Month: time.December, Day: 31},
+		nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 60},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2017, time.January, 1, 0, 0, 36, 0, time.UTC), actual)
+}
+
+func TestGPS(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		week      int
+		tow       time.Duration
+		date      nmea.Date
+		timeOfDay nmea.TimeOfDay
+	}{
+		{
+			name: "epoch",
+			date: nmea.Date{Year: 1980, Month: time.January, Day: 6},
+		},
+		{
+			name:      "pubx_04",
+			week:      1196,
+			tow:       113864 * time.Second,
+			date:      nmea.Date{Year: 2002, Month: time.December, Day: 9},
+			timeOfDay: nmea.TimeOfDay{Hour: 7, Minute: 37, Second: 31},
+		},
+		{
+			name:      "leap_second",
+			week:      1930,
+			tow:       17 * time.Second,
+			date:      nmea.Date{Year: 2016, Month: time.December, Day: 31},
+			timeOfDay: nmea.TimeOfDay{Hour: 23, Minute: 59, Second: 60},
+		},
+		{
+			name: "after_leap_second",
+			week: 1930,
+			tow:  18 * time.Second,
+			date: nmea.Date{Year: 2017, Month: time.January, Day: 1},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actualDate, actualTimeOfDay := timescale.GPSToUTC(tc.week, tc.tow)
+			assert.Equal(t, tc.date, actualDate)
+			assert.Equal(t, tc.timeOfDay, actualTimeOfDay)
+			actualWeek, actualTOW, err := timescale.UTCToGPS(tc.date, tc.timeOfDay)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.week, actualWeek)
+			assert.Equal(t, tc.tow, actualTOW)
+		})
+	}
+}
@@ -10,45 +10,108 @@ import (
 )
 
 var (
-	errExpectedComma       = errors.New("expected comma")
-	errExpectedDigit       = errors.New("expected digit")
-	errExpectedEndOfData   = errors.New("expected end of data")
-	errExpectedFloat       = errors.New("expected float")
-	errExpectedHexDigit    = errors.New("expected hex digit")
-	errExpectedRegexp      = errors.New("expected regexp")
-	errUnexpectedByte      = errors.New("unexpected byte")
-	errUnexpectedEndOfData = errors.New("unexpected end of data")
-
-	floatRegexp         = regexp.MustCompile(`\A-?\d+(?:\.\d*)?`)
-	unsignedFloatRegexp = regexp.MustCompile(`\A\d+(?:\.\d*)?`)
+	ErrExpectedComma       = errors.New("expected comma")
+	ErrExpectedDigit       = errors.New("expected digit")
+	ErrExpectedEndOfData   = errors.New("expected end of data")
+	ErrExpectedFloat       = errors.New("expected float")
+	ErrExpectedHexDigit    = errors.New("expected hex digit")
+	ErrExpectedRegexp      = errors.New("expected regexp")
+	ErrUnexpectedByte      = errors.New("unexpected byte")
+	ErrUnexpectedEndOfData = errors.New("unexpected end of data")
+
+	// float64Pow10 contains the powers of ten that can be represented exactly
+	// as float64s.
+	float64Pow10 = [...]float64{
+		1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10,
+		1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19, 1e20,
+		1e21, 1e22,
+	}
+)
+
+// A FieldKind is the kind of field that the tokenizer expected when an error
+// occurred.
+type FieldKind string
+
+const (
+	FieldKindByte          FieldKind = "byte"
+	FieldKindDate          FieldKind = "date"
+	FieldKindDecimal       FieldKind = "decimal"
+	FieldKindDigits        FieldKind = "digits"
+	FieldKindFloat         FieldKind = "float"
+	FieldKindHemisphere    FieldKind = "hemisphere"
+	FieldKindHex           FieldKind = "hex"
+	FieldKindInt           FieldKind = "int"
+	FieldKindLat           FieldKind = "lat"
+	FieldKindLiteral       FieldKind = "literal"
+	FieldKindLon           FieldKind = "lon"
+	FieldKindRegexp        FieldKind = "regexp"
+	FieldKindTimeOfDay     FieldKind = "time"
+	FieldKindUnit          FieldKind = "unit"
+	FieldKindUnsignedFloat FieldKind = "unsigned float"
+	FieldKindUnsignedInt   FieldKind = "unsigned int"
 )
 
+// A SyntaxError is an error in a sentence's fields.
+//
+// Field is the zero-based index of the field containing the error, where
+// field 0 is the address, and FieldText is that field's raw text. Kind is the
+// kind of field that was expected, if known. Address is only set when the
+// error is returned by a Parser.
 type SyntaxError struct {
-	Data []byte
-	Pos  int
-	Err  error
+	Data      []byte
+	Pos       int
+	Err       error
+	Address   string
+	Field     int
+	FieldText string
+	Kind      FieldKind
 }
 
 func (e *SyntaxError) Error() string {
-	return fmt.Sprintf("syntax error at position %d: %v", e.Pos, e.Err)
+	var sb strings.Builder
+	if e.Address != "" {
+		sb.WriteString(e.Address)
+		sb.WriteString(": ")
+	}
+	fmt.Fprintf(&sb, "field %d", e.Field)
+	if e.Kind != "" {
+		fmt.Fprintf(&sb, " (%s)", e.Kind)
+	}
+	fmt.Fprintf(&sb, " %q: syntax error at position %d: %v", e.FieldText, e.Pos, e.Err)
+	return sb.String()
 }
 
 func (e *SyntaxError) Unwrap() error {
 	return e.Err
 }
 
-type expectedLiteralError struct {
-	literal []byte
+// FormatError returns a multi-line diagnostic for err. If err is or wraps a
+// *SyntaxError then the diagnostic includes the sentence with a caret
+// pointing at the position of the error. Otherwise it is err's message.
+func FormatError(err error) string {
+	var syntaxError *SyntaxError
+	if !errors.As(err, &syntaxError) {
+		return err.Error()
+	}
+	return fmt.Sprintf("%s\n%s^ %v", syntaxError.Data, strings.Repeat(" ", syntaxError.Pos), syntaxError)
 }
 
-func (e *expectedLiteralError) Error() string {
-	return fmt.Sprintf("expected %q", e.literal)
+type ExpectedLiteralError struct {
+	Literal []byte
+}
+
+func (e *ExpectedLiteralError) Error() string {
+	return fmt.Sprintf("expected %q", e.Literal)
 }
 
 type Tokenizer struct {
-	data []byte
-	pos  int
-	err  error
+	data         []byte
+	pos          int
+	err          error
+	errKind      FieldKind
+	kind         FieldKind
+	address      string
+	encapsulated bool
 }
 
 func NewTokenizer(data []byte) *Tokenizer {
@@ -57,6 +120,24 @@ func NewTokenizer(data []byte) *Tokenizer {
 	}
 }
 
+// AnyByte returns the next byte, which can be any byte except a comma.
+func (t *Tokenizer) AnyByte() byte {
+	if t.err != nil {
+		return 0
+	}
+	if t.pos == len(t.data) {
+		t.fail(ErrUnexpectedEndOfData, FieldKindByte)
+		return 0
+	}
+	value := t.data[t.pos]
+	if value == ',' {
+		t.fail(ErrUnexpectedByte, FieldKindByte)
+		return 0
+	}
+	t.pos++
+	return value
+}
+
 func (t *Tokenizer) AtCommaOrEndOfData() bool {
 	if t.err != nil {
 		return true
@@ -96,16 +177,21 @@ func (t *Tokenizer) Comma() {
 		return
 	}
 	if t.pos == len(t.data) {
-		t.err = errUnexpectedEndOfData
+		t.fail(ErrUnexpectedEndOfData, "")
 		return
 	}
 	if t.data[t.pos] != ',' {
-		t.err = errExpectedComma
+		t.fail(ErrExpectedComma, "")
 		return
 	}
 	t.pos++
 }
 
+func (t *Tokenizer) CommaAnyByte() byte {
+	t.Comma()
+	return t.AnyByte()
+}
+
 func (t *Tokenizer) CommaEmpty() struct{} {
 	t.Comma()
 	return t.Empty()
@@ -118,7 +204,10 @@ func (t *Tokenizer) CommaFloat() float64 {
 
 func (t *Tokenizer) CommaFloatCommaUnit(unit byte) float64 {
 	value := t.CommaFloat()
+	prev := t.kind
+	t.kind = FieldKindUnit
 	t.CommaLiteralByte(unit)
+	t.kind = prev
 	return value
 }
 
@@ -139,15 +228,21 @@ func (t *Tokenizer) CommaInt() int {
 
 func (t *Tokenizer) CommaIntCommaUnit(unit byte) int {
 	value := t.CommaInt()
+	prev := t.kind
+	t.kind = FieldKindUnit
 	t.CommaLiteralByte(unit)
+	t.kind = prev
 	return value
 }
 
 func (t *Tokenizer) CommaLatCommaHemi() float64 {
+	prev := t.expect(FieldKindLat)
 	lat := t.CommaUnsignedFloat()
+	t.kind = FieldKindHemisphere
 	if t.CommaOneByteOf("NS") == 'S' {
 		lat = -lat
 	}
+	t.kind = prev
 	return lat
 }
 
@@ -172,10 +267,13 @@ func (t *Tokenizer) CommaLiteralString(s string) {
 }
 
 func (t *Tokenizer) CommaLonCommaHemi() float64 {
+	prev := t.expect(FieldKindLon)
 	lon := t.CommaUnsignedFloat()
+	t.kind = FieldKindHemisphere
 	if t.CommaOneByteOf("EW") == 'W' {
-		return -lon
+		lon = -lon
 	}
+	t.kind = prev
 	return lon
 }
 
@@ -189,6 +287,11 @@ func (t *Tokenizer) CommaOneByteOf(bytes string) byte {
 	return t.OneByteOf(bytes)
 }
 
+func (t *Tokenizer) CommaOptionalAnyByte() Optional[byte] {
+	t.Comma()
+	return t.OptionalAnyByte()
+}
+
 func (t *Tokenizer) CommaOptionalFloat() Optional[float64] {
 	t.Comma()
 	return t.OptionalFloat()
@@ -196,7 +299,10 @@ func (t *Tokenizer) CommaOptionalFloat() Optional[float64] {
 
 func (t *Tokenizer) CommaOptionalFloatCommaUnit(unit byte) Optional[float64] {
 	value := t.CommaOptionalFloat()
+	prev := t.kind
+	t.kind = FieldKindUnit
 	t.CommaOptionalLiteralByte(unit)
+	t.kind = prev
 	return value
 }
 
@@ -212,7 +318,10 @@ func (t *Tokenizer) CommaOptionalInt() Optional[int] {
 
 func (t *Tokenizer) CommaOptionalIntCommaUnit(unit byte) Optional[int] {
 	value := t.CommaOptionalInt()
+	prev := t.kind
+	t.kind = FieldKindUnit
 	t.CommaOptionalLiteralByte(unit)
+	t.kind = prev
 	return value
 }
 
@@ -291,12 +400,12 @@ func (t *Tokenizer) DecimalDigits(n int) int {
 	value := 0
 	for i := 0; i < n; i++ {
 		if t.pos == len(t.data) {
-			t.err = errUnexpectedEndOfData
+			t.fail(ErrUnexpectedEndOfData, FieldKindDigits)
 			return 0
 		}
 		digit, ok := digitValue(t.data[t.pos])
 		if !ok {
-			t.err = errExpectedDigit
+			t.fail(ErrExpectedDigit, FieldKindDigits)
 			return 0
 		}
 		value = 10*value + digit
@@ -309,12 +418,18 @@ func (t *Tokenizer) Empty() struct{} {
 	return struct{}{}
 }
 
+// Encapsulated returns true if the sentence being tokenized started with the
+// encapsulation start delimiter !.
+func (t *Tokenizer) Encapsulated() bool {
+	return t.encapsulated
+}
+
 func (t *Tokenizer) EndOfData() {
 	if t.err != nil {
 		return
 	}
 	if t.pos != len(t.data) {
-		t.err = errExpectedEndOfData
+		t.fail(ErrExpectedEndOfData, "")
 		return
 	}
 }
@@ -323,28 +438,24 @@ func (t *Tokenizer) Err() error {
 	if t.err == nil {
 		return nil
 	}
+	start := bytes.LastIndexByte(t.data[:t.pos], ',') + 1
+	end := len(t.data)
+	if i := bytes.IndexByte(t.data[t.pos:], ','); i != -1 {
+		end = t.pos + i
+	}
 	return &SyntaxError{
-		Data: t.data,
-		Pos:  t.pos,
-		Err:  t.err,
+		Data:      t.data,
+		Pos:       t.pos,
+		Err:       t.err,
+		Address:   t.address,
+		Field:     bytes.Count(t.data[:t.pos], []byte{','}),
+		FieldText: string(t.data[start:end]),
+		Kind:      t.errKind,
 	}
 }
 
 func (t *Tokenizer) Float() float64 {
-	if t.err != nil {
-		return 0
-	}
-	if t.pos == len(t.data) {
-		t.err = errUnexpectedEndOfData
-		return 0
-	}
-	m := t.Regexp(floatRegexp)
-	if m == nil {
-		t.err = errExpectedFloat
-		return 0
-	}
-	value, _ := strconv.ParseFloat(string(m[0]), 64)
-	return value
+	return t.float(true)
 }
 
 func (t *Tokenizer) Fork() *Tokenizer {
@@ -357,12 +468,12 @@ func (t *Tokenizer) Hex() int {
 		return 0
 	}
 	if t.pos == len(t.data) {
-		t.err = errUnexpectedEndOfData
+		t.fail(ErrUnexpectedEndOfData, FieldKindHex)
 		return 0
 	}
 	value, ok := hexDigitValue(t.data[t.pos])
 	if !ok {
-		t.err = errExpectedHexDigit
+		t.fail(ErrExpectedHexDigit, FieldKindHex)
 		return 0
 	}
 	t.pos++
@@ -388,13 +499,13 @@ func (t *Tokenizer) HexBytes() []byte {
 	for t.pos+1 < len(t.data) {
 		hexDigit1, ok := hexDigitValue(t.data[t.pos])
 		if !ok {
-			t.err = errExpectedHexDigit
+			t.fail(ErrExpectedHexDigit, FieldKindHex)
 			return nil
 		}
 		t.pos++
 		hexDigit2, ok := hexDigitValue(t.data[t.pos])
 		if !ok {
-			t.err = errExpectedHexDigit
+			t.fail(ErrExpectedHexDigit, FieldKindHex)
 			return nil
 		}
 		t.pos++
@@ -409,7 +520,7 @@ func (t *Tokenizer) Int() int {
 		return 0
 	}
 	if t.pos == len(t.data) {
-		t.err = errUnexpectedEndOfData
+		t.fail(ErrUnexpectedEndOfData, FieldKindInt)
 		return 0
 	}
 	sign := 1
@@ -421,13 +532,16 @@ func (t *Tokenizer) Int() int {
 }
 
 func (t *Tokenizer) LatDegMinCommaHemi() float64 {
+	prev := t.expect(FieldKindLat)
 	deg := t.DecimalDigits(2)
 	min := t.DecimalDigits(2)
 	numerator, denominator := t.PointDecimal()
 	lat := float64(deg) + (float64(min)+float64(numerator)/float64(denominator))/60
+	t.kind = FieldKindHemisphere
 	if t.CommaOneByteOf("NS") == 'S' {
 		lat = -lat
 	}
+	t.kind = prev
 	return lat
 }
 
@@ -436,11 +550,11 @@ func (t *Tokenizer) LiteralByte(b byte) {
 		return
 	}
 	if t.pos == len(t.data) {
-		t.err = errUnexpectedEndOfData
+		t.fail(ErrUnexpectedEndOfData, FieldKindLiteral)
 		return
 	}
 	if t.data[t.pos] != b {
-		t.err = errUnexpectedByte
+		t.fail(ErrUnexpectedByte, FieldKindLiteral)
 		return
 	}
 	t.pos++
@@ -451,9 +565,9 @@ func (t *Tokenizer) LiteralBytes(bs []byte) {
 		return
 	}
 	if !bytes.HasPrefix(t.data[t.pos:], bs) {
-		t.err = &expectedLiteralError{
-			literal: bs,
-		}
+		t.fail(&ExpectedLiteralError{
+			Literal: bs,
+		}, FieldKindLiteral)
 		return
 	}
 	t.pos += len(bs)
@@ -464,13 +578,16 @@ func (t *Tokenizer) LiteralString(s string) {
 }
 
 func (t *Tokenizer) LonDegMinCommaHemi() float64 {
+	prev := t.expect(FieldKindLon)
 	deg := t.DecimalDigits(3)
 	min := t.DecimalDigits(2)
 	numerator, denominator := t.PointDecimal()
 	lon := float64(deg) + (float64(min)+float64(numerator)/float64(denominator))/60
+	t.kind = FieldKindHemisphere
 	if t.CommaOneByteOf("EW") == 'W' {
 		lon = -lon
 	}
+	t.kind = prev
 	return lon
 }
 
@@ -479,18 +596,31 @@ func (t *Tokenizer) OneByteOf(bytes string) byte {
 		return 0
 	}
 	if t.pos == len(t.data) {
-		t.err = errUnexpectedEndOfData
+		t.fail(ErrUnexpectedEndOfData, FieldKindByte)
 		return 0
 	}
 	value := t.data[t.pos]
 	if strings.IndexByte(bytes, value) == -1 {
-		t.err = errUnexpectedByte
+		t.fail(ErrUnexpectedByte, FieldKindByte)
 		return 0
 	}
 	t.pos++
 	return value
 }
 
+func (t *Tokenizer) OptionalAnyByte() Optional[byte] {
+	if t.err != nil {
+		return Optional[byte]{}
+	}
+	if t.pos == len(t.data) {
+		return Optional[byte]{}
+	}
+	if t.data[t.pos] == ',' {
+		return Optional[byte]{}
+	}
+	return NewOptional(t.AnyByte())
+}
+
 func (t *Tokenizer) OptionalFloat() Optional[float64] {
 	if t.err != nil {
 		return Optional[float64]{}
@@ -544,7 +674,7 @@ func (t *Tokenizer) OptionalLiteralByte(b byte) Optional[byte] {
 	case ',':
 		return Optional[byte]{}
 	default:
-		t.err = errUnexpectedByte
+		t.fail(ErrUnexpectedByte, FieldKindLiteral)
 		return Optional[byte]{}
 	}
 }
@@ -629,11 +759,11 @@ func (t *Tokenizer) PointDecimal() (int, int) {
 		return 0, 1
 	}
 	if t.pos == len(t.data) {
-		t.err = errUnexpectedEndOfData
+		t.fail(ErrUnexpectedEndOfData, FieldKindDecimal)
 		return 0, 1
 	}
 	if t.data[t.pos] != '.' {
-		t.err = errUnexpectedByte
+		t.fail(ErrUnexpectedByte, FieldKindDecimal)
 		return 0, 1
 	}
 	t.pos++
@@ -657,13 +787,19 @@ func (t *Tokenizer) Regexp(regexp *regexp.Regexp) [][]byte {
 	}
 	m := regexp.FindSubmatch(t.data[t.pos:])
 	if m == nil {
-		t.err = errExpectedRegexp
+		t.fail(ErrExpectedRegexp, FieldKindRegexp)
 		return nil
 	}
 	t.pos += len(m[0])
 	return m
 }
 
+func (t *Tokenizer) Reset(data []byte) {
+	*t = Tokenizer{
+		data: data,
+	}
+}
+
 func (t *Tokenizer) Rest() []byte {
 	if t.err != nil {
 		return nil
@@ -685,47 +821,147 @@ func (t *Tokenizer) String() string {
 }
 
 func (t *Tokenizer) UnsignedFloat() float64 {
+	return t.float(false)
+}
+
+func (t *Tokenizer) UnsignedInt() int {
 	if t.err != nil {
 		return 0
 	}
 	if t.pos == len(t.data) {
-		t.err = errUnexpectedEndOfData
+		t.fail(ErrUnexpectedEndOfData, FieldKindUnsignedInt)
 		return 0
 	}
-	m := t.Regexp(unsignedFloatRegexp)
-	if m == nil {
-		t.err = errExpectedFloat
+	if t.data[t.pos] < '0' || '9' < t.data[t.pos] {
+		t.fail(ErrExpectedDigit, FieldKindUnsignedInt)
 		return 0
 	}
-	value, _ := strconv.ParseFloat(string(m[0]), 64)
+	value := int(t.data[t.pos] - '0')
+	t.pos++
+	for t.pos < len(t.data) {
+		digit, ok := digitValue(t.data[t.pos])
+		if !ok {
+			break
+		}
+		value = 10*value + digit
+		t.pos++
+	}
 	return value
 }
 
-func (t *Tokenizer) UnsignedInt() int {
+// float parses a float of the form -?\d+(?:\.\d*)? without allocating.
+// Values with at most 15 significant digits, which includes all values that
+// occur in practice, are converted exactly with a single floating point
+// operation. Longer values fall back to strconv.ParseFloat.
+func (t *Tokenizer) float(signed bool) float64 {
 	if t.err != nil {
 		return 0
 	}
 	if t.pos == len(t.data) {
-		t.err = errUnexpectedEndOfData
+		if signed {
+			t.fail(ErrUnexpectedEndOfData, FieldKindFloat)
+		} else {
+			t.fail(ErrUnexpectedEndOfData, FieldKindUnsignedFloat)
+		}
 		return 0
 	}
-	if t.data[t.pos] < '0' || '9' < t.data[t.pos] {
-		t.err = errExpectedDigit
-		return 0
+	kind := FieldKindFloat
+	if !signed {
+		kind = FieldKindUnsignedFloat
 	}
-	value := int(t.data[t.pos] - '0')
-	t.pos++
+	start := t.pos
+	negative := false
+	if signed {
+		switch t.data[t.pos] {
+		case '-':
+			negative = true
+			t.pos++
+		case '+':
+			t.pos++
+		}
+	}
+	var mantissa uint64
+	significantDigits := 0
+	exponent := 0
+	integerDigits := 0
 	for t.pos < len(t.data) {
 		digit, ok := digitValue(t.data[t.pos])
 		if !ok {
 			break
 		}
-		value = 10*value + digit
+		if significantDigits < 19 {
+			mantissa = 10*mantissa + uint64(digit)
+			if mantissa != 0 {
+				significantDigits++
+			}
+		} else {
+			exponent++
+			significantDigits++
+		}
+		integerDigits++
+		t.pos++
+	}
+	if integerDigits == 0 {
+		t.pos = start
+		t.fail(ErrExpectedFloat, kind)
+		return 0
+	}
+	if t.pos < len(t.data) && t.data[t.pos] == '.' {
 		t.pos++
+		for t.pos < len(t.data) {
+			digit, ok := digitValue(t.data[t.pos])
+			if !ok {
+				break
+			}
+			if significantDigits < 19 {
+				mantissa = 10*mantissa + uint64(digit)
+				exponent--
+				if mantissa != 0 {
+					significantDigits++
+				}
+			} else {
+				significantDigits++
+			}
+			t.pos++
+		}
+	}
+	var value float64
+	switch {
+	case significantDigits > 15 || -exponent >= len(float64Pow10) || exponent >= len(float64Pow10):
+		value, _ = strconv.ParseFloat(string(t.data[start:t.pos]), 64)
+		return value
+	case exponent < 0:
+		value = float64(mantissa) / float64Pow10[-exponent]
+	default:
+		value = float64(mantissa) * float64Pow10[exponent]
+	}
+	if negative {
+		value = -value
 	}
 	return value
 }
 
+// fail records err. The kind of the error is the kind set by the outermost
+// enclosing call to expect, or kind if there is none.
+func (t *Tokenizer) fail(err error, kind FieldKind) {
+	t.err = err
+	t.errKind = t.kind
+	if t.errKind == "" {
+		t.errKind = kind
+	}
+}
+
+// expect sets the kind of field that is expected, unless an enclosing method
+// has already set it, and returns the previous kind, which the caller must
+// restore.
+func (t *Tokenizer) expect(kind FieldKind) FieldKind {
+	prev := t.kind
+	if prev == "" {
+		t.kind = kind
+	}
+	return prev
+}
+
 func digitValue(c byte) (int, bool) {
 	if '0' <= c && c <= '9' {
 		return int(c - '0'), true
@@ -1,6 +1,7 @@
 package nmea_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
@@ -16,6 +17,43 @@ func TestTokenizer(t *testing.T) {
 	assert.NoError(t, tok.Err())
 }
 
+func TestTokenizer_AnyByte(t *testing.T) {
+	for _, tc := range []struct {
+		s           string
+		expectedErr error
+		expected    byte
+	}{
+		{
+			s:           "",
+			expectedErr: nmea.ErrUnexpectedEndOfData,
+		},
+		{
+			s:           ",",
+			expectedErr: nmea.ErrUnexpectedByte,
+		},
+		{
+			s:        "A",
+			expected: 'A',
+		},
+		{
+			s:        "#",
+			expected: '#',
+		},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			tok := nmea.NewTokenizer([]byte(tc.s))
+			actual := tok.AnyByte()
+			err := tok.Err()
+			if tc.expectedErr != nil {
+				assert.IsError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestTokenizer_Float(t *testing.T) {
 	for _, tc := range []struct {
 		s           string
@@ -34,6 +72,46 @@ func TestTokenizer_Float(t *testing.T) {
 			s:        "-1.23",
 			expected: -1.23,
 		},
+		{
+			s:        "+1.23",
+			expected: 1.23,
+		},
+		{
+			s:        "1.",
+			expected: 1,
+		},
+		{
+			s:        "0.000123",
+			expected: 0.000123,
+		},
+		{
+			s:        "4717.11399",
+			expected: 4717.11399,
+		},
+		{
+			s:        "123456789.0123456789",
+			expected: 123456789.0123456789,
+		},
+		{
+			s:           "",
+			expectedErr: nmea.ErrUnexpectedEndOfData,
+		},
+		{
+			s:           "-",
+			expectedErr: nmea.ErrExpectedFloat,
+		},
+		{
+			s:           "+",
+			expectedErr: nmea.ErrExpectedFloat,
+		},
+		{
+			s:           ".",
+			expectedErr: nmea.ErrExpectedFloat,
+		},
+		{
+			s:           "a",
+			expectedErr: nmea.ErrExpectedFloat,
+		},
 	} {
 		t.Run(tc.s, func(t *testing.T) {
 			tok := nmea.NewTokenizer([]byte(tc.s))
@@ -137,3 +215,109 @@ func TestTokenizer_OptionalInt(t *testing.T) {
 		})
 	}
 }
+
+func TestSyntaxError(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		s                 string
+		parse             func(*nmea.Tokenizer)
+		expectedErr       error
+		expectedField     int
+		expectedFieldText string
+		expectedKind      nmea.FieldKind
+		expectedPos       int
+		expectedFormat    string
+	}{
+		{
+			name: "hemisphere",
+			s:    "GPGLL,4717.11399,X",
+			parse: func(tok *nmea.Tokenizer) {
+				tok.CommaLatDegMinCommaHemi()
+			},
+			expectedErr:       nmea.ErrUnexpectedByte,
+			expectedField:     2,
+			expectedFieldText: "X",
+			expectedKind:      nmea.FieldKindHemisphere,
+			expectedPos:       17,
+			expectedFormat: "" +
+				"GPGLL,4717.11399,X\n" +
+				"                 ^ field 2 (hemisphere) \"X\": syntax error at position 17: unexpected byte",
+		},
+		{
+			name: "lat",
+			s:    "GPGLL,47a7.11399,N",
+			parse: func(tok *nmea.Tokenizer) {
+				tok.CommaLatDegMinCommaHemi()
+			},
+			expectedErr:       nmea.ErrExpectedDigit,
+			expectedField:     1,
+			expectedFieldText: "47a7.11399",
+			expectedKind:      nmea.FieldKindLat,
+			expectedPos:       8,
+		},
+		{
+			name: "time",
+			s:    "GPZDA,1234",
+			parse: func(tok *nmea.Tokenizer) {
+				tok.CommaTimeOfDay()
+			},
+			expectedErr:       nmea.ErrUnexpectedEndOfData,
+			expectedField:     1,
+			expectedFieldText: "1234",
+			expectedKind:      nmea.FieldKindTimeOfDay,
+			expectedPos:       10,
+		},
+		{
+			name: "unit",
+			s:    "SDDBT,1.0,m",
+			parse: func(tok *nmea.Tokenizer) {
+				tok.CommaFloatCommaUnit('f')
+			},
+			expectedField:     2,
+			expectedFieldText: "m",
+			expectedKind:      nmea.FieldKindUnit,
+			expectedPos:       10,
+		},
+		{
+			name: "float",
+			s:    "SDDBT,x,f",
+			parse: func(tok *nmea.Tokenizer) {
+				tok.CommaFloat()
+			},
+			expectedErr:       nmea.ErrExpectedFloat,
+			expectedField:     1,
+			expectedFieldText: "x",
+			expectedKind:      nmea.FieldKindFloat,
+			expectedPos:       6,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tok := nmea.NewTokenizer([]byte(tc.s))
+			_ = tok.String()
+			tc.parse(tok)
+			err := tok.Err()
+			var syntaxError *nmea.SyntaxError
+			assert.True(t, errors.As(err, &syntaxError))
+			if tc.expectedErr != nil {
+				assert.IsError(t, err, tc.expectedErr)
+			}
+			assert.Equal(t, tc.expectedField, syntaxError.Field)
+			assert.Equal(t, tc.expectedFieldText, syntaxError.FieldText)
+			assert.Equal(t, tc.expectedKind, syntaxError.Kind)
+			assert.Equal(t, tc.expectedPos, syntaxError.Pos)
+			if tc.expectedFormat != "" {
+				assert.Equal(t, tc.expectedFormat, nmea.FormatError(err))
+			}
+		})
+	}
+}
+
+func BenchmarkTokenizer_Float(b *testing.B) {
+	data := []byte("4717.11399")
+	tok := nmea.NewTokenizer(data)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		tok.Reset(data)
+		tok.Float()
+	}
+}
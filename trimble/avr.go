@@ -0,0 +1,44 @@
+package trimble
+
+import "github.com/twpayne/go-nmea"
+
+// An AVR is a time, yaw, tilt, and range sentence for moving baseline RTK.
+// Angles are in degrees and the range between the antennas is in meters.
+type AVR struct {
+	nmea.Address
+	TimeOfDay          nmea.TimeOfDay
+	Yaw                nmea.Optional[float64]
+	Tilt               nmea.Optional[float64]
+	Roll               nmea.Optional[float64]
+	Range              float64
+	FixQuality         FixQuality
+	PDOP               float64
+	NumberOfSatellites int
+}
+
+func ParseAVR(addr string, tok *nmea.Tokenizer) (*AVR, error) {
+	var a AVR
+	a.Address = nmea.NewAddress(addr)
+	a.TimeOfDay = tok.CommaTimeOfDay()
+	a.Yaw = commaOptionalAngleCommaLabel(tok, "Yaw")
+	a.Tilt = commaOptionalAngleCommaLabel(tok, "Tilt")
+	a.Roll = commaOptionalAngleCommaLabel(tok, "Roll")
+	a.Range = tok.CommaUnsignedFloat()
+	a.FixQuality = FixQuality(tok.CommaUnsignedInt())
+	a.PDOP = tok.CommaUnsignedFloat()
+	a.NumberOfSatellites = tok.CommaUnsignedInt()
+	tok.EndOfData()
+	return &a, tok.Err()
+}
+
+func (a *AVR) Encode(b *nmea.Builder) {
+	b.CommaString("AVR")
+	b.CommaTimeOfDay(a.TimeOfDay, 1)
+	encodeCommaOptionalAngleCommaLabel(b, a.Yaw, "Yaw")
+	encodeCommaOptionalAngleCommaLabel(b, a.Tilt, "Tilt")
+	encodeCommaOptionalAngleCommaLabel(b, a.Roll, "Roll")
+	b.CommaFloat(a.Range, 3)
+	b.CommaInt(int(a.FixQuality))
+	b.CommaFloat(a.PDOP, 1)
+	b.CommaInt(a.NumberOfSatellites)
+}
@@ -0,0 +1,72 @@
+package trimble
+
+// A FixQuality is a GPS quality indicator.
+type FixQuality int
+
+// Fix qualities.
+const (
+	FixQualityInvalid           FixQuality = 0
+	FixQualityAutonomous        FixQuality = 1
+	FixQualityRTKFloat          FixQuality = 2
+	FixQualityRTKFixed          FixQuality = 3
+	FixQualityDGPS              FixQuality = 4
+	FixQualitySBAS              FixQuality = 5
+	FixQualityNetworkRTKFloat3D FixQuality = 6
+	FixQualityNetworkRTKFixed3D FixQuality = 7
+	FixQualityNetworkRTKFloat2D FixQuality = 8
+	FixQualityNetworkRTKFixed2D FixQuality = 9
+	FixQualityOmniSTARHP        FixQuality = 10
+	FixQualityOmniSTARVBS       FixQuality = 11
+	FixQualityLocationRTK       FixQuality = 12
+	FixQualityBeaconDGPS        FixQuality = 13
+	FixQualityCenterPointRTX    FixQuality = 14
+)
+
+var fixQualityStrings = map[FixQuality]string{
+	FixQualityInvalid:           "invalid",
+	FixQualityAutonomous:        "autonomous",
+	FixQualityRTKFloat:          "RTK float",
+	FixQualityRTKFixed:          "RTK fixed",
+	FixQualityDGPS:              "DGPS",
+	FixQualitySBAS:              "SBAS",
+	FixQualityNetworkRTKFloat3D: "network RTK float 3D",
+	FixQualityNetworkRTKFixed3D: "network RTK fixed 3D",
+	FixQualityNetworkRTKFloat2D: "network RTK float 2D",
+	FixQualityNetworkRTKFixed2D: "network RTK fixed 2D",
+	FixQualityOmniSTARHP:        "OmniSTAR HP/XP",
+	FixQualityOmniSTARVBS:       "OmniSTAR VBS",
+	FixQualityLocationRTK:       "location RTK",
+	FixQualityBeaconDGPS:        "beacon DGPS",
+	FixQualityCenterPointRTX:    "CenterPoint RTX",
+}
+
+// RTK returns whether q is an RTK solution, either float or fixed.
+func (q FixQuality) RTK() bool {
+	switch q {
+	case FixQualityRTKFloat, FixQualityRTKFixed,
+		FixQualityNetworkRTKFloat3D, FixQualityNetworkRTKFixed3D,
+		FixQualityNetworkRTKFloat2D, FixQualityNetworkRTKFixed2D,
+		FixQualityLocationRTK:
+		return true
+	default:
+		return false
+	}
+}
+
+// RTKFixed returns whether q is an RTK solution with fixed integer
+// ambiguities.
+func (q FixQuality) RTKFixed() bool {
+	switch q {
+	case FixQualityRTKFixed, FixQualityNetworkRTKFixed3D, FixQualityNetworkRTKFixed2D:
+		return true
+	default:
+		return false
+	}
+}
+
+func (q FixQuality) String() string {
+	if s, ok := fixQualityStrings[q]; ok {
+		return s
+	}
+	return "unknown"
+}
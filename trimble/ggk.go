@@ -0,0 +1,44 @@
+package trimble
+
+import "github.com/twpayne/go-nmea"
+
+// A GGK is a time, position, position type, and DOP sentence.
+type GGK struct {
+	nmea.Address
+	TimeOfDay          nmea.TimeOfDay
+	Date               nmea.Date
+	Lat                float64
+	Lon                float64
+	FixQuality         FixQuality
+	NumberOfSatellites int
+	DOP                float64
+	HeightType         byte
+	Height             float64
+}
+
+func ParseGGK(addr string, tok *nmea.Tokenizer) (*GGK, error) {
+	var g GGK
+	g.Address = nmea.NewAddress(addr)
+	g.TimeOfDay = tok.CommaTimeOfDay()
+	g.Date = commaDate(tok)
+	g.Lat = tok.CommaLatDegMinCommaHemi()
+	g.Lon = tok.CommaLonDegMinCommaHemi()
+	g.FixQuality = FixQuality(tok.CommaUnsignedInt())
+	g.NumberOfSatellites = tok.CommaUnsignedInt()
+	g.DOP = tok.CommaUnsignedFloat()
+	g.HeightType, g.Height = commaHeightCommaUnit(tok)
+	tok.EndOfData()
+	return &g, tok.Err()
+}
+
+func (g *GGK) Encode(b *nmea.Builder) {
+	b.CommaString("GGK")
+	b.CommaTimeOfDay(g.TimeOfDay, 2)
+	encodeCommaDate(b, g.Date)
+	b.CommaLatDegMinCommaHemi(g.Lat, 8)
+	b.CommaLonDegMinCommaHemi(g.Lon, 8)
+	b.CommaInt(int(g.FixQuality))
+	b.CommaZeroPaddedInt(g.NumberOfSatellites, 2)
+	b.CommaFloat(g.DOP, 1)
+	encodeCommaHeightCommaUnit(b, g.HeightType, g.Height)
+}
@@ -0,0 +1,48 @@
+package trimble
+
+import "github.com/twpayne/go-nmea"
+
+// A PJK is a local coordinate position sentence.
+type PJK struct {
+	nmea.Address
+	TimeOfDay          nmea.TimeOfDay
+	Date               nmea.Date
+	Northing           float64
+	Easting            float64
+	FixQuality         FixQuality
+	NumberOfSatellites int
+	DOP                float64
+	HeightType         byte
+	Height             float64
+}
+
+func ParsePJK(addr string, tok *nmea.Tokenizer) (*PJK, error) {
+	var p PJK
+	p.Address = nmea.NewAddress(addr)
+	p.TimeOfDay = tok.CommaTimeOfDay()
+	p.Date = commaDate(tok)
+	p.Northing = tok.CommaFloat()
+	tok.CommaLiteralByte('N')
+	p.Easting = tok.CommaFloat()
+	tok.CommaLiteralByte('E')
+	p.FixQuality = FixQuality(tok.CommaUnsignedInt())
+	p.NumberOfSatellites = tok.CommaUnsignedInt()
+	p.DOP = tok.CommaUnsignedFloat()
+	p.HeightType, p.Height = commaHeightCommaUnit(tok)
+	tok.EndOfData()
+	return &p, tok.Err()
+}
+
+func (p *PJK) Encode(b *nmea.Builder) {
+	b.CommaString("PJK")
+	b.CommaTimeOfDay(p.TimeOfDay, 2)
+	encodeCommaDate(b, p.Date)
+	b.CommaFloat(p.Northing, 3)
+	b.CommaByte('N')
+	b.CommaFloat(p.Easting, 3)
+	b.CommaByte('E')
+	b.CommaInt(int(p.FixQuality))
+	b.CommaZeroPaddedInt(p.NumberOfSatellites, 2)
+	b.CommaFloat(p.DOP, 1)
+	encodeCommaHeightCommaUnit(b, p.HeightType, p.Height)
+}
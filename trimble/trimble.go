@@ -0,0 +1,114 @@
+// Package trimble parses and encodes Trimble PTNL NMEA sentences.
+//
+// See https://receiverhelp.trimble.com/alloy-gnss/en-us/NMEA-0183messages_MessageOverview.html.
+package trimble
+
+import (
+	"time"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// Height types.
+const (
+	HeightTypeEllipsoidal byte = 'E'
+	HeightTypeGeoid       byte = 'G'
+)
+
+var sentenceParserMap = map[string]nmea.SentenceParser{
+	"AVR": nmea.MakeSentenceParser(ParseAVR),
+	"GGK": nmea.MakeSentenceParser(ParseGGK),
+	"PJK": nmea.MakeSentenceParser(ParsePJK),
+	"VGK": nmea.MakeSentenceParser(ParseVGK),
+	"VHD": nmea.MakeSentenceParser(ParseVHD),
+}
+
+type UnknownMessageTypeError struct {
+	MessageType string
+}
+
+func (e *UnknownMessageTypeError) Error() string {
+	return e.MessageType + ": unknown message type"
+}
+
+func ParseSentence(addr string, tok *nmea.Tokenizer) (nmea.Sentence, error) {
+	messageType := tok.CommaString()
+	if err := tok.Err(); err != nil {
+		return nil, err
+	}
+	sentenceParser := sentenceParserMap[messageType]
+	if sentenceParser != nil {
+		return sentenceParser(addr, tok)
+	}
+	return nil, &UnknownMessageTypeError{
+		MessageType: messageType,
+	}
+}
+
+func SentenceParserFunc(addr string) nmea.SentenceParser {
+	if addr != "PTNL" {
+		return nil
+	}
+	return ParseSentence
+}
+
+// commaDate parses a comma followed by a date in mmddyy format.
+func commaDate(tok *nmea.Tokenizer) nmea.Date {
+	tok.Comma()
+	month := time.Month(tok.DecimalDigits(2))
+	day := tok.DecimalDigits(2)
+	year := 1900 + tok.DecimalDigits(2)
+	if year < 1993 {
+		year += 100
+	}
+	return nmea.Date{
+		Year:  year,
+		Month: month,
+		Day:   day,
+	}
+}
+
+// commaHeightCommaUnit parses a comma followed by a height prefixed with EHT
+// or GHT, followed by a comma and the unit M.
+func commaHeightCommaUnit(tok *nmea.Tokenizer) (byte, float64) {
+	tok.Comma()
+	heightType := tok.OneByteOf("EG")
+	tok.LiteralString("HT")
+	height := tok.Float()
+	tok.CommaLiteralByte('M')
+	return heightType, height
+}
+
+// commaOptionalAngleCommaLabel parses a comma followed by an optional angle,
+// followed by a comma and label if the angle is present.
+func commaOptionalAngleCommaLabel(tok *nmea.Tokenizer, label string) nmea.Optional[float64] {
+	angle := tok.CommaOptionalFloat()
+	tok.Comma()
+	if angle.Valid {
+		tok.LiteralString(label)
+	}
+	return angle
+}
+
+func encodeCommaDate(b *nmea.Builder, date nmea.Date) {
+	b.Comma()
+	b.ZeroPaddedInt(int(date.Month), 2)
+	b.ZeroPaddedInt(date.Day, 2)
+	b.ZeroPaddedInt(date.Year%100, 2)
+}
+
+func encodeCommaHeightCommaUnit(b *nmea.Builder, heightType byte, height float64) {
+	b.Comma()
+	b.Byte(heightType)
+	b.String("HT")
+	b.Float(height, 3)
+	b.CommaByte('M')
+}
+
+func encodeCommaOptionalAngleCommaLabel(b *nmea.Builder, angle nmea.Optional[float64], label string) {
+	b.CommaOptionalFloat(angle, 4)
+	b.Comma()
+	if angle.Valid {
+		b.String(label)
+	}
+}
@@ -0,0 +1,159 @@
+package trimble_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea"
+	"github.com/twpayne/go-nmea/nmeatest"
+	"github.com/twpayne/go-nmea/trimble"
+)
+
+func TestSentenceParserFunc(t *testing.T) {
+	nmeatest.TestSentenceParserFunc(t,
+		[]nmea.ParserOption{
+			nmea.WithChecksumDiscipline(nmea.ChecksumDisciplineStrict),
+			nmea.WithLineEndingDiscipline(nmea.LineEndingDisciplineNever),
+			nmea.WithSentenceParserFunc(trimble.SentenceParserFunc),
+		},
+		[]nmeatest.TestCase{
+			{
+				S: "$PTNL,AVR,181059.6,+149.4688,Yaw,+0.0134,Tilt,,,60.191,3,2.5,6*00",
+				Expected: &trimble.AVR{
+					Address: nmea.NewAddress("PTNL"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:       18,
+						Minute:     10,
+						Second:     59,
+						Nanosecond: 600000000,
+					},
+					Yaw:                nmea.NewOptional(149.4688),
+					Tilt:               nmea.NewOptional(0.0134),
+					Range:              60.191,
+					FixQuality:         trimble.FixQualityRTKFixed,
+					PDOP:               2.5,
+					NumberOfSatellites: 6,
+				},
+			},
+			{
+				S: "$PTNL,GGK,102939.00,051910,5000.97323841,N,00827.62010742,E,5,09,1.9,EHT150.790,M*73",
+				Expected: &trimble.GGK{
+					Address: nmea.NewAddress("PTNL"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   10,
+						Minute: 29,
+						Second: 39,
+					},
+					Date: nmea.Date{
+						Year:  2010,
+						Month: time.May,
+						Day:   19,
+					},
+					Lat:                50.016220640166665,
+					Lon:                8.460335123666667,
+					FixQuality:         trimble.FixQualitySBAS,
+					NumberOfSatellites: 9,
+					DOP:                1.9,
+					HeightType:         trimble.HeightTypeEllipsoidal,
+					Height:             150.79,
+				},
+			},
+			{
+				S: "$PTNL,PJK,202831.50,011112,+805083.350,N,+388997.346,E,10,09,1.5,GHT+25.478,M*77",
+				Expected: &trimble.PJK{
+					Address: nmea.NewAddress("PTNL"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:       20,
+						Minute:     28,
+						Second:     31,
+						Nanosecond: 500000000,
+					},
+					Date: nmea.Date{
+						Year:  2012,
+						Month: time.January,
+						Day:   11,
+					},
+					Northing:           805083.35,
+					Easting:            388997.346,
+					FixQuality:         trimble.FixQualityOmniSTARHP,
+					NumberOfSatellites: 9,
+					DOP:                1.5,
+					HeightType:         trimble.HeightTypeGeoid,
+					Height:             25.478,
+				},
+			},
+			{
+				S: "$PTNL,VGK,160159.00,010997,-0000.161,00009.985,-0000.002,3,07,1.4,M*0B",
+				Expected: &trimble.VGK{
+					Address: nmea.NewAddress("PTNL"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   16,
+						Minute: 1,
+						Second: 59,
+					},
+					Date: nmea.Date{
+						Year:  1997,
+						Month: time.January,
+						Day:   9,
+					},
+					East:               -0.161,
+					North:              9.985,
+					Up:                 -0.002,
+					FixQuality:         trimble.FixQualityRTKFixed,
+					NumberOfSatellites: 7,
+					DOP:                1.4,
+				},
+			},
+			{
+				S: "$PTNL,VHD,030556.00,093098,187.718,-22.138,-76.929,-5.015,0.033,0.006,3,07,2.4,M*22",
+				Expected: &trimble.VHD{
+					Address: nmea.NewAddress("PTNL"),
+					TimeOfDay: nmea.TimeOfDay{
+						Hour:   3,
+						Minute: 5,
+						Second: 56,
+					},
+					Date: nmea.Date{
+						Year:  1998,
+						Month: time.September,
+						Day:   30,
+					},
+					Azimuth:            187.718,
+					AzimuthRate:        -22.138,
+					VerticalAngle:      -76.929,
+					VerticalAngleRate:  -5.015,
+					Range:              0.033,
+					RangeRate:          0.006,
+					FixQuality:         trimble.FixQualityRTKFixed,
+					NumberOfSatellites: 7,
+					PDOP:               2.4,
+				},
+			},
+		})
+}
+
+func TestFixQuality(t *testing.T) {
+	for _, tc := range []struct {
+		fixQuality       trimble.FixQuality
+		expectedRTK      bool
+		expectedRTKFixed bool
+		expectedString   string
+	}{
+		{fixQuality: trimble.FixQualityAutonomous, expectedString: "autonomous"},
+		{fixQuality: trimble.FixQualityRTKFloat, expectedRTK: true, expectedString: "RTK float"},
+		{fixQuality: trimble.FixQualityRTKFixed, expectedRTK: true, expectedRTKFixed: true, expectedString: "RTK fixed"},
+		{fixQuality: trimble.FixQualityNetworkRTKFixed3D, expectedRTK: true, expectedRTKFixed: true, expectedString: "network RTK fixed 3D"},
+		{fixQuality: 99, expectedString: "unknown"},
+	} {
+		assert.Equal(t, tc.expectedRTK, tc.fixQuality.RTK())
+		assert.Equal(t, tc.expectedRTKFixed, tc.fixQuality.RTKFixed())
+		assert.Equal(t, tc.expectedString, tc.fixQuality.String())
+	}
+}
+
+func TestVGKLength(t *testing.T) {
+	vgk := &trimble.VGK{East: 3, North: 4, Up: 12}
+	assert.Equal(t, 13.0, vgk.Length())
+}
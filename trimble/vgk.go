@@ -0,0 +1,55 @@
+package trimble
+
+import (
+	"math"
+
+	"github.com/twpayne/go-nmea"
+)
+
+// A VGK is a baseline vector sentence. The components of the vector from
+// the base to the rover are in meters.
+type VGK struct {
+	nmea.Address
+	TimeOfDay          nmea.TimeOfDay
+	Date               nmea.Date
+	East               float64
+	North              float64
+	Up                 float64
+	FixQuality         FixQuality
+	NumberOfSatellites int
+	DOP                float64
+}
+
+func ParseVGK(addr string, tok *nmea.Tokenizer) (*VGK, error) {
+	var v VGK
+	v.Address = nmea.NewAddress(addr)
+	v.TimeOfDay = tok.CommaTimeOfDay()
+	v.Date = commaDate(tok)
+	v.East = tok.CommaFloat()
+	v.North = tok.CommaFloat()
+	v.Up = tok.CommaFloat()
+	v.FixQuality = FixQuality(tok.CommaUnsignedInt())
+	v.NumberOfSatellites = tok.CommaUnsignedInt()
+	v.DOP = tok.CommaUnsignedFloat()
+	tok.CommaLiteralByte('M')
+	tok.EndOfData()
+	return &v, tok.Err()
+}
+
+func (v *VGK) Encode(b *nmea.Builder) {
+	b.CommaString("VGK")
+	b.CommaTimeOfDay(v.TimeOfDay, 2)
+	encodeCommaDate(b, v.Date)
+	b.CommaFloat(v.East, 3)
+	b.CommaFloat(v.North, 3)
+	b.CommaFloat(v.Up, 3)
+	b.CommaInt(int(v.FixQuality))
+	b.CommaZeroPaddedInt(v.NumberOfSatellites, 2)
+	b.CommaFloat(v.DOP, 1)
+	b.CommaByte('M')
+}
+
+// Length returns the length of the baseline vector in meters.
+func (v *VGK) Length() float64 {
+	return math.Sqrt(v.East*v.East + v.North*v.North + v.Up*v.Up)
+}
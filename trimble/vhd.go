@@ -0,0 +1,55 @@
+package trimble
+
+import "github.com/twpayne/go-nmea"
+
+// A VHD is a heading information sentence. Angles are in degrees, the range
+// is in meters, and rates are per second.
+type VHD struct {
+	nmea.Address
+	TimeOfDay          nmea.TimeOfDay
+	Date               nmea.Date
+	Azimuth            float64
+	AzimuthRate        float64
+	VerticalAngle      float64
+	VerticalAngleRate  float64
+	Range              float64
+	RangeRate          float64
+	FixQuality         FixQuality
+	NumberOfSatellites int
+	PDOP               float64
+}
+
+func ParseVHD(addr string, tok *nmea.Tokenizer) (*VHD, error) {
+	var v VHD
+	v.Address = nmea.NewAddress(addr)
+	v.TimeOfDay = tok.CommaTimeOfDay()
+	v.Date = commaDate(tok)
+	v.Azimuth = tok.CommaFloat()
+	v.AzimuthRate = tok.CommaFloat()
+	v.VerticalAngle = tok.CommaFloat()
+	v.VerticalAngleRate = tok.CommaFloat()
+	v.Range = tok.CommaUnsignedFloat()
+	v.RangeRate = tok.CommaFloat()
+	v.FixQuality = FixQuality(tok.CommaUnsignedInt())
+	v.NumberOfSatellites = tok.CommaUnsignedInt()
+	v.PDOP = tok.CommaUnsignedFloat()
+	tok.CommaLiteralByte('M')
+	tok.EndOfData()
+	return &v, tok.Err()
+}
+
+func (v *VHD) Encode(b *nmea.Builder) {
+	b.CommaString("VHD")
+	b.CommaTimeOfDay(v.TimeOfDay, 2)
+	encodeCommaDate(b, v.Date)
+	b.CommaFloat(v.Azimuth, 3)
+	b.CommaFloat(v.AzimuthRate, 3)
+	b.CommaFloat(v.VerticalAngle, 3)
+	b.CommaFloat(v.VerticalAngleRate, 3)
+	b.CommaFloat(v.Range, 3)
+	b.CommaFloat(v.RangeRate, 3)
+	b.CommaInt(int(v.FixQuality))
+	b.CommaZeroPaddedInt(v.NumberOfSatellites, 2)
+	b.CommaFloat(v.PDOP, 1)
+	b.CommaByte('M')
+}
@@ -0,0 +1,35 @@
+package ublox
+
+import "github.com/twpayne/go-nmea/standard"
+
+// Satellite returns the canonical satellite of ss. PUBX,03 sentences use
+// u-blox's own satellite numbering:
+//
+//	1-32    GPS
+//	33-64   BeiDou PRN 6-37
+//	65-96   GLONASS slots 1-32
+//	120-158 SBAS
+//	159-163 BeiDou PRN 1-5
+//	193-202 QZSS PRN 1-10
+//	211-246 Galileo PRN 1-36
+func (ss SatelliteStatus) Satellite() (standard.Satellite, bool) {
+	svid := ss.SVID
+	switch {
+	case 1 <= svid && svid <= 32:
+		return standard.Satellite{Constellation: standard.ConstellationGPS, PRN: svid}, true
+	case 33 <= svid && svid <= 64:
+		return standard.Satellite{Constellation: standard.ConstellationBeiDou, PRN: svid - 27}, true
+	case 65 <= svid && svid <= 96:
+		return standard.Satellite{Constellation: standard.ConstellationGLONASS, PRN: svid - 64}, true
+	case 120 <= svid && svid <= 158:
+		return standard.Satellite{Constellation: standard.ConstellationSBAS, PRN: svid}, true
+	case 159 <= svid && svid <= 163:
+		return standard.Satellite{Constellation: standard.ConstellationBeiDou, PRN: svid - 158}, true
+	case 193 <= svid && svid <= 202:
+		return standard.Satellite{Constellation: standard.ConstellationQZSS, PRN: svid - 192}, true
+	case 211 <= svid && svid <= 246:
+		return standard.Satellite{Constellation: standard.ConstellationGalileo, PRN: svid - 210}, true
+	default:
+		return standard.Satellite{}, false
+	}
+}
@@ -0,0 +1,31 @@
+package ublox_test
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-nmea/standard"
+	"github.com/twpayne/go-nmea/ublox"
+)
+
+func TestSatelliteStatusSatellite(t *testing.T) {
+	for _, tc := range []struct {
+		svid       int
+		expectedOK bool
+		expected   standard.Satellite
+	}{
+		{svid: 23, expectedOK: true, expected: standard.Satellite{Constellation: standard.ConstellationGPS, PRN: 23}},
+		{svid: 33, expectedOK: true, expected: standard.Satellite{Constellation: standard.ConstellationBeiDou, PRN: 6}},
+		{svid: 65, expectedOK: true, expected: standard.Satellite{Constellation: standard.ConstellationGLONASS, PRN: 1}},
+		{svid: 123, expectedOK: true, expected: standard.Satellite{Constellation: standard.ConstellationSBAS, PRN: 123}},
+		{svid: 159, expectedOK: true, expected: standard.Satellite{Constellation: standard.ConstellationBeiDou, PRN: 1}},
+		{svid: 193, expectedOK: true, expected: standard.Satellite{Constellation: standard.ConstellationQZSS, PRN: 1}},
+		{svid: 211, expectedOK: true, expected: standard.Satellite{Constellation: standard.ConstellationGalileo, PRN: 1}},
+		{svid: 255},
+	} {
+		satellite, ok := ublox.SatelliteStatus{SVID: tc.svid}.Satellite()
+		assert.Equal(t, tc.expectedOK, ok)
+		assert.Equal(t, tc.expected, satellite)
+	}
+}
@@ -23,7 +23,7 @@ func ParseTime(addr string, tok *nmea.Tokenizer) (*Time, error) {
 	t.Address = nmea.NewAddress(addr)
 	timeOfDay := tok.CommaTimeOfDay()
 	date := tok.CommaDate()
-	t.Time = time.Date(date.Year, date.Month, date.Day, timeOfDay.Hour, timeOfDay.Minute, timeOfDay.Second, timeOfDay.Nanosecond, time.UTC)
+	t.Time = date.At(timeOfDay)
 	t.UTCTimeOfWeek = tok.CommaUnsignedFloat()
 	t.UTCWeek = tok.CommaUnsignedInt()
 	t.LeapSeconds = tok.CommaUnsignedInt()
@@ -1,16 +1,34 @@
 package nmea
 
+// An Unknown is a sentence without a registered parser. Encapsulated is true
+// if the sentence started with ! rather than $, so that it is encoded with
+// the same start delimiter.
 type Unknown struct {
 	Address
-	Fields []string
+	Encapsulated bool
+	Fields       []string
 }
 
 func ParseUnknown(addr string, tok *Tokenizer) (*Unknown, error) {
 	var u Unknown
 	u.Address = NewAddress(addr)
+	u.Encapsulated = tok.Encapsulated()
 	for !tok.AtEndOfData() {
 		field := tok.CommaString()
 		u.Fields = append(u.Fields, field)
 	}
 	return &u, tok.Err()
 }
+
+func (u *Unknown) Encode(b *Builder) {
+	for _, field := range u.Fields {
+		b.CommaString(field)
+	}
+}
+
+func (u *Unknown) StartDelimiter() byte {
+	if u.Encapsulated {
+		return '!'
+	}
+	return '$'
+}